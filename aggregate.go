@@ -0,0 +1,127 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Strategy selects how RepresentativeGRPCCode reduces several HTTP statuses to one gRPC code.
+type Strategy int
+
+const (
+	// StrategyWorst picks the most severe status.
+	StrategyWorst Strategy = iota
+	// StrategyFirst picks the first status.
+	StrategyFirst
+	// StrategyMostCommon picks the most frequent gRPC code.
+	StrategyMostCommon
+)
+
+// httpStatusSeverity ranks httpStatusCode for StrategyWorst: 2 for 5xx, 1 for 4xx and 0 otherwise.
+func httpStatusSeverity(httpStatusCode int) int {
+	switch {
+	case httpStatusCode >= http.StatusInternalServerError:
+		return 2
+	case httpStatusCode >= http.StatusBadRequest:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// RepresentativeGRPCCode reduces httpStatuses to a single gRPC code using strategy.
+// StrategyWorst picks the first 5xx status, else the first 4xx status, else the first status.
+// StrategyFirst picks the first status.
+// StrategyMostCommon picks the most frequent gRPC code, the earliest one winning ties.
+// It returns codes.Unknown for an empty input or an unknown strategy.
+func RepresentativeGRPCCode(httpStatuses []int, strategy Strategy) codes.Code {
+	if len(httpStatuses) == 0 {
+		return codes.Unknown
+	}
+
+	switch strategy {
+	case StrategyWorst:
+		var worst int = httpStatuses[0]
+
+		for i := range httpStatuses {
+			if httpStatusSeverity(httpStatuses[i]) > httpStatusSeverity(worst) {
+				worst = httpStatuses[i]
+			}
+		}
+
+		return GRPCCodeFromHTTPStatusCode(worst)
+	case StrategyFirst:
+		return GRPCCodeFromHTTPStatusCode(httpStatuses[0])
+	case StrategyMostCommon:
+		var (
+			counts     map[codes.Code]int = map[codes.Code]int{}
+			mostCommon codes.Code
+			highest    int
+		)
+
+		for i := range httpStatuses {
+			counts[GRPCCodeFromHTTPStatusCode(httpStatuses[i])]++
+		}
+
+		for i := range httpStatuses {
+			var grpcCode codes.Code = GRPCCodeFromHTTPStatusCode(httpStatuses[i])
+
+			if counts[grpcCode] > highest {
+				mostCommon = grpcCode
+				highest = counts[grpcCode]
+			}
+		}
+
+		return mostCommon
+	default:
+		return codes.Unknown
+	}
+}
+
+// WithMixedBatchStatus sets the status BatchHTTPStatus returns for batches that only partially succeeded,
+// such as 200 OK for clients that do not understand 207 Multi-Status.
+func WithMixedBatchStatus(httpStatusCode int) Option {
+	return func(s *converterState) {
+		s.mixedBatchHTTPStatusCode = httpStatusCode
+	}
+}
+
+// BatchHTTPStatus returns the HTTP status code of a batch response whose items ended with itemCodes:
+// 200 OK when every item succeeded, 207 Multi-Status, or the status set with WithMixedBatchStatus, when only some did,
+// and the status of the worst failure, as ranked by StrategyWorst, when every item failed.
+func (c *Converter) BatchHTTPStatus(itemCodes []codes.Code) int {
+	var (
+		s      *converterState = c.state.Load()
+		failed int
+		worst  int
+	)
+
+	for i := range itemCodes {
+		if itemCodes[i] == codes.OK {
+			continue
+		}
+
+		var httpStatusCode int = LookupOrDefault(s.grpcToHTTP, itemCodes[i], http.StatusInternalServerError)
+
+		if failed == 0 || httpStatusSeverity(httpStatusCode) > httpStatusSeverity(worst) {
+			worst = httpStatusCode
+		}
+
+		failed++
+	}
+
+	switch {
+	case failed == 0:
+		return http.StatusOK
+	case failed < len(itemCodes):
+		return s.mixedBatchHTTPStatusCode
+	default:
+		return worst
+	}
+}
+
+// BatchHTTPStatus is (*Converter).BatchHTTPStatus on the default converter.
+func BatchHTTPStatus(itemCodes []codes.Code) int {
+	return defaultConverter.BatchHTTPStatus(itemCodes)
+}
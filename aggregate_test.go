@@ -0,0 +1,142 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestRepresentativeGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name         string
+		HTTPStatuses []int
+		Strategy     Strategy
+		Expectation  codes.Code
+	} = []struct {
+		Name         string
+		HTTPStatuses []int
+		Strategy     Strategy
+		Expectation  codes.Code
+	}{
+		{
+			Name:         "worst",
+			HTTPStatuses: []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusNotFound},
+			Strategy:     StrategyWorst,
+			Expectation:  codes.Internal,
+		},
+		{
+			Name:         "first",
+			HTTPStatuses: []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusNotFound},
+			Strategy:     StrategyFirst,
+			Expectation:  codes.OK,
+		},
+		{
+			Name:         "most common",
+			HTTPStatuses: []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusNotFound},
+			Strategy:     StrategyMostCommon,
+			Expectation:  codes.NotFound,
+		},
+		{
+			Name:         "worst without server error",
+			HTTPStatuses: []int{http.StatusOK, http.StatusConflict, http.StatusNotFound},
+			Strategy:     StrategyWorst,
+			Expectation:  codes.AlreadyExists,
+		},
+		{
+			Name:         "most common tie",
+			HTTPStatuses: []int{http.StatusNotFound, http.StatusOK, http.StatusOK, http.StatusNotFound},
+			Strategy:     StrategyMostCommon,
+			Expectation:  codes.NotFound,
+		},
+		{
+			Name:         "empty",
+			HTTPStatuses: nil,
+			Strategy:     StrategyWorst,
+			Expectation:  codes.Unknown,
+		},
+		{
+			Name:         "unknown strategy",
+			HTTPStatuses: []int{http.StatusOK},
+			Strategy:     Strategy(-1),
+			Expectation:  codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = RepresentativeGRPCCode(testCases[i].HTTPStatuses, testCases[i].Strategy)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestBatchHTTPStatus(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		ItemCodes   []codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		ItemCodes   []codes.Code
+		Expectation int
+	}{
+		{
+			Name:        "all ok",
+			ItemCodes:   []codes.Code{codes.OK, codes.OK},
+			Expectation: http.StatusOK,
+		},
+		{
+			Name:        "mixed",
+			ItemCodes:   []codes.Code{codes.OK, codes.NotFound, codes.OK},
+			Expectation: http.StatusMultiStatus,
+		},
+		{
+			Name:        "all failed",
+			ItemCodes:   []codes.Code{codes.NotFound, codes.Unavailable, codes.Internal},
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        "all failed client errors",
+			ItemCodes:   []codes.Code{codes.InvalidArgument, codes.NotFound},
+			Expectation: http.StatusBadRequest,
+		},
+		{
+			Name:        "empty",
+			ItemCodes:   nil,
+			Expectation: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = BatchHTTPStatus(testCases[i].ItemCodes)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithMixedBatchStatus(t *testing.T) {
+	var (
+		itemCodes []codes.Code = []codes.Code{codes.OK, codes.NotFound}
+		actual    int
+	)
+
+	actual = NewConverter().BatchHTTPStatus(itemCodes)
+
+	if actual != http.StatusMultiStatus {
+		t.Errorf("expectation is %d, got %d", http.StatusMultiStatus, actual)
+	}
+
+	actual = NewConverter(WithMixedBatchStatus(http.StatusOK)).BatchHTTPStatus(itemCodes)
+
+	if actual != http.StatusOK {
+		t.Errorf("expectation is %d, got %d", http.StatusOK, actual)
+	}
+}
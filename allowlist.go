@@ -0,0 +1,19 @@
+package gostacode
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodeFromHTTPStatusCodeAllowlist maps httpStatusCode like GRPCCodeFromHTTPStatusCode
+// but returns an error wrapping ErrHTTPStatusCodeNotAllowed when httpStatusCode is not in allowed.
+func GRPCCodeFromHTTPStatusCodeAllowlist(httpStatusCode int, allowed []int) (codes.Code, error) {
+	for i := range allowed {
+		if allowed[i] == httpStatusCode {
+			return GRPCCodeFromHTTPStatusCode(httpStatusCode), nil
+		}
+	}
+
+	return codes.Unknown, fmt.Errorf("%w: %d", ErrHTTPStatusCodeNotAllowed, httpStatusCode)
+}
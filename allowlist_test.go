@@ -0,0 +1,57 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodeFromHTTPStatusCodeAllowlist(t *testing.T) {
+	var allowed []int = []int{http.StatusOK, http.StatusNotFound, http.StatusServiceUnavailable}
+
+	var testCases []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationError    error
+	} = []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationError    error
+	}{
+		{
+			Name:                http.StatusText(http.StatusNotFound),
+			HTTPStatusCode:      http.StatusNotFound,
+			ExpectationGRPCCode: codes.NotFound,
+			ExpectationError:    nil,
+		},
+		{
+			Name:                http.StatusText(http.StatusTeapot),
+			HTTPStatusCode:      http.StatusTeapot,
+			ExpectationGRPCCode: codes.Unknown,
+			ExpectationError:    ErrHTTPStatusCodeNotAllowed,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual codes.Code
+				err    error
+			)
+
+			actual, err = GRPCCodeFromHTTPStatusCodeAllowlist(testCases[i].HTTPStatusCode, allowed)
+
+			if testCases[i].ExpectationGRPCCode != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationGRPCCode, actual)
+			}
+
+			if !errors.Is(err, testCases[i].ExpectationError) {
+				t.Errorf("expectation is %v, got %v", testCases[i].ExpectationError, err)
+			}
+		})
+	}
+}
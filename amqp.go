@@ -0,0 +1,45 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// AMQP reply codes as defined by the AMQP 0-9-1 specification.
+const (
+	amqpReplySuccess       int = 200
+	amqpContentTooLarge    int = 311
+	amqpConnectionForced   int = 320
+	amqpAccessRefused      int = 403
+	amqpNotFound           int = 404
+	amqpPreconditionFailed int = 406
+	amqpInternalError      int = 541
+)
+
+var (
+	grpcAMQPReplyCodeMap map[codes.Code]int = map[codes.Code]int{
+		codes.OK:                 amqpReplySuccess,
+		codes.ResourceExhausted:  amqpContentTooLarge,
+		codes.Unavailable:        amqpConnectionForced,
+		codes.PermissionDenied:   amqpAccessRefused,
+		codes.NotFound:           amqpNotFound,
+		codes.FailedPrecondition: amqpPreconditionFailed,
+		codes.Unknown:            amqpInternalError,
+		codes.Internal:           amqpInternalError,
+	}
+)
+
+// AMQPReplyCodeFromGRPCCode returns the AMQP 0-9-1 reply code for grpcCode.
+// Codes without an AMQP counterpart map to 541 internal-error.
+func AMQPReplyCodeFromGRPCCode(grpcCode codes.Code) int {
+	var (
+		replyCode int
+		ok        bool
+	)
+
+	replyCode, ok = grpcAMQPReplyCodeMap[grpcCode]
+	if !ok {
+		return amqpInternalError
+	}
+
+	return replyCode
+}
@@ -0,0 +1,75 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestAMQPReplyCodeFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: 200,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: 403,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: 404,
+		},
+		{
+			Name:        codes.FailedPrecondition.String(),
+			GRPCCode:    codes.FailedPrecondition,
+			Expectation: 406,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: 311,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: 320,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: 541,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: 541,
+		},
+		{
+			Name:        codes.Aborted.String(),
+			GRPCCode:    codes.Aborted,
+			Expectation: 541,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = AMQPReplyCodeFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package gostacode
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+)
+
+// AzureError is an error in the Azure REST API shape.
+// Status is the HTTP status code of the response carrying it and is not part of the JSON body.
+type AzureError struct {
+	Code    string
+	Message string
+	Status  int
+}
+
+type azureErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type azureErrorEnvelope struct {
+	Error azureErrorDetail `json:"error"`
+}
+
+// AzureErrorFromGRPCCode returns an AzureError whose code is the gRPC code name
+// and whose status is mapped from grpcCode.
+func AzureErrorFromGRPCCode(grpcCode codes.Code, message string) AzureError {
+	return AzureError{
+		Code:    grpcCode.String(),
+		Message: message,
+		Status:  HTTPStatusCodeFromGRPCCode(grpcCode),
+	}
+}
+
+// MarshalJSON encodes e as {"error":{"code":"...","message":"..."}}.
+func (e AzureError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(azureErrorEnvelope{
+		Error: azureErrorDetail{
+			Code:    e.Code,
+			Message: e.Message,
+		},
+	})
+}
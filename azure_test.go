@@ -0,0 +1,63 @@
+package gostacode
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestAzureErrorFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name              string
+		GRPCCode          codes.Code
+		Message           string
+		ExpectationJSON   string
+		ExpectationStatus int
+	} = []struct {
+		Name              string
+		GRPCCode          codes.Code
+		Message           string
+		ExpectationJSON   string
+		ExpectationStatus int
+	}{
+		{
+			Name:              codes.NotFound.String(),
+			GRPCCode:          codes.NotFound,
+			Message:           "user not found",
+			ExpectationJSON:   `{"error":{"code":"NotFound","message":"user not found"}}`,
+			ExpectationStatus: http.StatusNotFound,
+		},
+		{
+			Name:              codes.InvalidArgument.String(),
+			GRPCCode:          codes.InvalidArgument,
+			Message:           "name is required",
+			ExpectationJSON:   `{"error":{"code":"InvalidArgument","message":"name is required"}}`,
+			ExpectationStatus: http.StatusBadRequest,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual     AzureError = AzureErrorFromGRPCCode(testCases[i].GRPCCode, testCases[i].Message)
+				actualJSON []byte
+				err        error
+			)
+
+			actualJSON, err = json.Marshal(actual)
+			if err != nil {
+				t.Fatalf("expectation is nil error, got %v", err)
+			}
+
+			if testCases[i].ExpectationJSON != string(actualJSON) {
+				t.Errorf("expectation is %s, got %s", testCases[i].ExpectationJSON, actualJSON)
+			}
+
+			if testCases[i].ExpectationStatus != actual.Status {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationStatus, actual.Status)
+			}
+		})
+	}
+}
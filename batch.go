@@ -0,0 +1,63 @@
+package gostacode
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodesFromHTTPStatusCodesStrict converts httpCodes like GRPCCodeFromHTTPStatusCode and returns
+// a parallel slice of errors, where the error is non-nil and wraps ErrUnmappedCode
+// for every input missing from the mapping table that does not resolve to codes.OK,
+// so unmapped 2xx codes are not errors.
+func GRPCCodesFromHTTPStatusCodesStrict(httpCodes []int) ([]codes.Code, []error) {
+	var (
+		grpcCodes []codes.Code = make([]codes.Code, len(httpCodes))
+		errs      []error      = make([]error, len(httpCodes))
+	)
+
+	for i := range httpCodes {
+		var ok bool
+
+		grpcCodes[i], ok = GRPCCodeFromHTTPStatusCodeOK(httpCodes[i])
+		if !ok && grpcCodes[i] != codes.OK {
+			errs[i] = fmt.Errorf("%w: http status code %d", ErrUnmappedCode, httpCodes[i])
+		}
+	}
+
+	return grpcCodes, errs
+}
+
+// GRPCCodesFromHTTPStatusCodes converts httpStatusCodes like GRPCCodeFromHTTPStatusCode into dst and returns it.
+// dst is resliced to len(httpStatusCodes) and only reallocated when its capacity is too small,
+// so a caller reusing a large enough dst converts without allocating.
+func GRPCCodesFromHTTPStatusCodes(httpStatusCodes []int, dst []codes.Code) []codes.Code {
+	if cap(dst) < len(httpStatusCodes) {
+		dst = make([]codes.Code, len(httpStatusCodes))
+	}
+
+	dst = dst[:len(httpStatusCodes)]
+
+	for i := range httpStatusCodes {
+		dst[i] = GRPCCodeFromHTTPStatusCode(httpStatusCodes[i])
+	}
+
+	return dst
+}
+
+// HTTPStatusCodesFromGRPCCodes converts grpcCodes like HTTPStatusCodeFromGRPCCode into dst and returns it.
+// dst is resliced to len(grpcCodes) and only reallocated when its capacity is too small,
+// so a caller reusing a large enough dst converts without allocating.
+func HTTPStatusCodesFromGRPCCodes(grpcCodes []codes.Code, dst []int) []int {
+	if cap(dst) < len(grpcCodes) {
+		dst = make([]int, len(grpcCodes))
+	}
+
+	dst = dst[:len(grpcCodes)]
+
+	for i := range grpcCodes {
+		dst[i] = HTTPStatusCodeFromGRPCCode(grpcCodes[i])
+	}
+
+	return dst
+}
@@ -0,0 +1,189 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodesFromHTTPStatusCodesStrict(t *testing.T) {
+	var (
+		httpCodes            []int        = []int{http.StatusOK, http.StatusTeapot, http.StatusNotFound, 255, http.StatusHTTPVersionNotSupported}
+		expectationGRPCCodes []codes.Code = []codes.Code{codes.OK, codes.Unknown, codes.NotFound, codes.OK, codes.Unknown}
+		expectationErrors    []error      = []error{nil, ErrUnmappedCode, nil, nil, ErrUnmappedCode}
+		actualGRPCCodes      []codes.Code
+		actualErrors         []error
+	)
+
+	actualGRPCCodes, actualErrors = GRPCCodesFromHTTPStatusCodesStrict(httpCodes)
+
+	if len(httpCodes) != len(actualGRPCCodes) || len(httpCodes) != len(actualErrors) {
+		t.Fatalf("expectation is %d results, got %d codes and %d errors", len(httpCodes), len(actualGRPCCodes), len(actualErrors))
+	}
+
+	for i := range httpCodes {
+		if expectationGRPCCodes[i] != actualGRPCCodes[i] {
+			t.Errorf("index %d: expectation is %d, got %d", i, expectationGRPCCodes[i], actualGRPCCodes[i])
+		}
+
+		if expectationErrors[i] == nil && actualErrors[i] != nil {
+			t.Errorf("index %d: expectation is nil error, got %v", i, actualErrors[i])
+		}
+
+		if expectationErrors[i] != nil && !errors.Is(actualErrors[i], expectationErrors[i]) {
+			t.Errorf("index %d: expectation is %v, got %v", i, expectationErrors[i], actualErrors[i])
+		}
+	}
+}
+
+func TestGRPCCodesFromHTTPStatusCodes(t *testing.T) {
+	var (
+		httpStatusCodes []int        = []int{http.StatusOK, http.StatusNotFound, http.StatusTeapot, http.StatusServiceUnavailable}
+		expectation     []codes.Code = []codes.Code{codes.OK, codes.NotFound, codes.Unknown, codes.Unavailable}
+	)
+
+	var testCases []struct {
+		Name string
+		Dst  []codes.Code
+	} = []struct {
+		Name string
+		Dst  []codes.Code
+	}{
+		{
+			Name: "nil",
+			Dst:  nil,
+		},
+		{
+			Name: "short",
+			Dst:  make([]codes.Code, 1),
+		},
+		{
+			Name: "exactly sized",
+			Dst:  make([]codes.Code, len(httpStatusCodes)),
+		},
+		{
+			Name: "larger",
+			Dst:  make([]codes.Code, 2*len(httpStatusCodes)),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []codes.Code = GRPCCodesFromHTTPStatusCodes(httpStatusCodes, testCases[i].Dst)
+
+			if len(expectation) != len(actual) {
+				t.Fatalf("expectation length is %d, got %d", len(expectation), len(actual))
+			}
+
+			for j := range expectation {
+				if expectation[j] != actual[j] {
+					t.Errorf("index %d: expectation is %d, got %d", j, expectation[j], actual[j])
+				}
+			}
+
+			if cap(testCases[i].Dst) >= len(httpStatusCodes) && &testCases[i].Dst[0] != &actual[0] {
+				t.Errorf("expectation is dst reused")
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodesFromGRPCCodes(t *testing.T) {
+	var (
+		grpcCodes   []codes.Code = []codes.Code{codes.OK, codes.NotFound, codes.Code(99), codes.Unavailable}
+		expectation []int        = []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError, http.StatusServiceUnavailable}
+	)
+
+	var testCases []struct {
+		Name string
+		Dst  []int
+	} = []struct {
+		Name string
+		Dst  []int
+	}{
+		{
+			Name: "nil",
+			Dst:  nil,
+		},
+		{
+			Name: "short",
+			Dst:  make([]int, 1),
+		},
+		{
+			Name: "exactly sized",
+			Dst:  make([]int, len(grpcCodes)),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []int = HTTPStatusCodesFromGRPCCodes(grpcCodes, testCases[i].Dst)
+
+			if len(expectation) != len(actual) {
+				t.Fatalf("expectation length is %d, got %d", len(expectation), len(actual))
+			}
+
+			for j := range expectation {
+				if expectation[j] != actual[j] {
+					t.Errorf("index %d: expectation is %d, got %d", j, expectation[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func TestGRPCCodesFromHTTPStatusCodesAllocs(t *testing.T) {
+	var (
+		httpStatusCodes []int        = []int{http.StatusOK, http.StatusNotFound, http.StatusTeapot, http.StatusServiceUnavailable}
+		dst             []codes.Code = make([]codes.Code, len(httpStatusCodes))
+	)
+
+	var actual float64 = testing.AllocsPerRun(100, func() {
+		dst = GRPCCodesFromHTTPStatusCodes(httpStatusCodes, dst)
+	})
+
+	if actual != 0 {
+		t.Errorf("expectation is 0 allocations, got %v", actual)
+	}
+}
+
+func benchmarkHTTPStatusCodes() []int {
+	var httpStatusCodes []int = make([]int, 4096)
+
+	for i := range httpStatusCodes {
+		httpStatusCodes[i] = 200 + i%400
+	}
+
+	return httpStatusCodes
+}
+
+func BenchmarkGRPCCodesFromHTTPStatusCodes(b *testing.B) {
+	var (
+		httpStatusCodes []int        = benchmarkHTTPStatusCodes()
+		dst             []codes.Code = make([]codes.Code, len(httpStatusCodes))
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		dst = GRPCCodesFromHTTPStatusCodes(httpStatusCodes, dst)
+	}
+}
+
+func BenchmarkGRPCCodesFromHTTPStatusCodesNaive(b *testing.B) {
+	var httpStatusCodes []int = benchmarkHTTPStatusCodes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var dst []codes.Code
+
+		for i := range httpStatusCodes {
+			dst = append(dst, GRPCCodeFromHTTPStatusCode(httpStatusCodes[i]))
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package gostacode
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+// BodyMode selects the error body format EstimatedBodySizeForGRPCCode measures.
+type BodyMode int
+
+const (
+	// BodyModePlain is a plain text body holding the message.
+	BodyModePlain BodyMode = iota
+	// BodyModeProblemJSON is an RFC 7807 application/problem+json body.
+	BodyModeProblemJSON
+	// BodyModeJSONAPI is a JSON:API errors document.
+	BodyModeJSONAPI
+)
+
+type jsonAPIError struct {
+	Status string `json:"status"`
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+}
+
+type jsonAPIBody struct {
+	Errors []jsonAPIError `json:"errors"`
+}
+
+// EstimatedBodySizeForGRPCCode returns the byte length of the error body written for grpcCode in mode,
+// using the status text of the mapped HTTP status code as the message.
+// It returns 0 for an unknown mode.
+func EstimatedBodySizeForGRPCCode(grpcCode codes.Code, mode BodyMode) int {
+	var (
+		httpStatusCode int    = HTTPStatusCodeFromGRPCCode(grpcCode)
+		title          string = http.StatusText(httpStatusCode)
+		body           []byte
+		err            error
+	)
+
+	switch mode {
+	case BodyModePlain:
+		return len(title) + len("\n")
+	case BodyModeProblemJSON:
+		body, err = json.Marshal(ProblemFromGRPCCode(grpcCode, ""))
+	case BodyModeJSONAPI:
+		body, err = json.Marshal(jsonAPIBody{
+			Errors: []jsonAPIError{
+				{
+					Status: strconv.Itoa(httpStatusCode),
+					Code:   grpcCode.String(),
+					Title:  title,
+				},
+			},
+		})
+	default:
+		return 0
+	}
+
+	if err != nil {
+		return 0
+	}
+
+	return len(body)
+}
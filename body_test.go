@@ -0,0 +1,64 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestEstimatedBodySizeForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Mode        BodyMode
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Mode        BodyMode
+		Expectation int
+	}{
+		{
+			Name:        "plain",
+			GRPCCode:    codes.NotFound,
+			Mode:        BodyModePlain,
+			Expectation: len("Not Found\n"),
+		},
+		{
+			Name:        "problem json",
+			GRPCCode:    codes.NotFound,
+			Mode:        BodyModeProblemJSON,
+			Expectation: len(`{"type":"about:blank","title":"Not Found","status":404}`),
+		},
+		{
+			Name:        "json api",
+			GRPCCode:    codes.NotFound,
+			Mode:        BodyModeJSONAPI,
+			Expectation: len(`{"errors":[{"status":"404","code":"NotFound","title":"Not Found"}]}`),
+		},
+		{
+			Name:        "unknown mode",
+			GRPCCode:    codes.NotFound,
+			Mode:        BodyMode(-1),
+			Expectation: 0,
+		},
+	}
+
+	var sizes map[int]bool = map[int]bool{}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = EstimatedBodySizeForGRPCCode(testCases[i].GRPCCode, testCases[i].Mode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+
+			if sizes[actual] {
+				t.Errorf("expectation is a size distinct from the other modes, got %d", actual)
+			}
+
+			sizes[actual] = true
+		})
+	}
+}
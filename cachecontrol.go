@@ -0,0 +1,47 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	grpcCacheControlMap map[codes.Code]string = map[codes.Code]string{
+		codes.Unauthenticated:  "no-cache",
+		codes.PermissionDenied: "no-cache",
+		codes.NotFound:         "max-age=60",
+	}
+)
+
+// WithCacheControlForGRPCCode overrides the Cache-Control directive CacheControl returns for grpcCode.
+func WithCacheControlForGRPCCode(grpcCode codes.Code, directive string) Option {
+	return func(s *converterState) {
+		s.cacheControls[grpcCode] = directive
+	}
+}
+
+// CacheControl returns the Cache-Control directive for an error response derived from grpcCode.
+// NotFound is negatively cached for a short time, Unauthenticated and PermissionDenied must be revalidated,
+// and every other code, including the server errors, is not stored, unless overridden with WithCacheControlForGRPCCode.
+func (c *Converter) CacheControl(grpcCode codes.Code) string {
+	var (
+		directive string
+		ok        bool
+	)
+
+	directive, ok = c.state.Load().cacheControls[grpcCode]
+	if ok {
+		return directive
+	}
+
+	directive, ok = grpcCacheControlMap[grpcCode]
+	if !ok {
+		return "no-store"
+	}
+
+	return directive
+}
+
+// CacheControlForGRPCCode is (*Converter).CacheControl on the default converter.
+func CacheControlForGRPCCode(grpcCode codes.Code) string {
+	return defaultConverter.CacheControl(grpcCode)
+}
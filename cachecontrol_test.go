@@ -0,0 +1,72 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCacheControlForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	}{
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: "no-store",
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: "no-store",
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: "no-cache",
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: "no-cache",
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: "max-age=60",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = CacheControlForGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithCacheControlForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithCacheControlForGRPCCode(codes.NotFound, "max-age=5"))
+		actual string     = c.CacheControl(codes.NotFound)
+	)
+
+	if actual != "max-age=5" {
+		t.Errorf("expectation is %q, got %q", "max-age=5", actual)
+	}
+
+	actual = CacheControlForGRPCCode(codes.NotFound)
+
+	if actual != "max-age=60" {
+		t.Errorf("expectation is %q, got %q", "max-age=60", actual)
+	}
+}
@@ -0,0 +1,66 @@
+package gostacode
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const captivePortalBodyLimit int64 = 64 << 10
+
+var (
+	metaTagPattern        *regexp.Regexp = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaRefreshPattern    *regexp.Regexp = regexp.MustCompile(`(?i)http-equiv\s*=\s*["']?refresh`)
+	metaRefreshURLPattern *regexp.Regexp = regexp.MustCompile(`(?i)content\s*=\s*["'][^"']*?url\s*=\s*([^"'\s>]+)`)
+)
+
+// CaptivePortalHint reports whether resp is a 511 Network Authentication Required response
+// and returns the portal URL found in its Location header or, failing that, in a meta refresh tag of its body.
+// The part of the body read to find the tag is restored, so resp.Body can still be read in full.
+func CaptivePortalHint(resp *http.Response) (string, bool) {
+	if resp == nil || resp.StatusCode != http.StatusNetworkAuthenticationRequired {
+		return "", false
+	}
+
+	var location string = resp.Header.Get("Location")
+	if location != "" {
+		return location, true
+	}
+
+	if resp.Body == nil {
+		return "", true
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+
+	body, err = io.ReadAll(io.LimitReader(resp.Body, captivePortalBodyLimit))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(body), resp.Body),
+		Closer: resp.Body,
+	}
+
+	if err != nil {
+		return "", true
+	}
+
+	for _, tag := range metaTagPattern.FindAll(body, -1) {
+		if !metaRefreshPattern.Match(tag) {
+			continue
+		}
+
+		var match [][]byte = metaRefreshURLPattern.FindSubmatch(tag)
+		if match != nil {
+			return strings.TrimSpace(string(match[1])), true
+		}
+	}
+
+	return "", true
+}
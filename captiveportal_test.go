@@ -0,0 +1,110 @@
+package gostacode
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCaptivePortalHint(t *testing.T) {
+	var testCases []struct {
+		Name                 string
+		Response             *http.Response
+		ExpectationURL       string
+		ExpectationIsCaptive bool
+	} = []struct {
+		Name                 string
+		Response             *http.Response
+		ExpectationURL       string
+		ExpectationIsCaptive bool
+	}{
+		{
+			Name: "511 with location",
+			Response: &http.Response{
+				StatusCode: http.StatusNetworkAuthenticationRequired,
+				Header:     http.Header{"Location": []string{"https://portal.example.com/login"}},
+			},
+			ExpectationURL:       "https://portal.example.com/login",
+			ExpectationIsCaptive: true,
+		},
+		{
+			Name: "511 with meta refresh",
+			Response: &http.Response{
+				StatusCode: http.StatusNetworkAuthenticationRequired,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`<html><head><meta http-equiv="refresh" content="0; url=https://portal.example.com/"></head></html>`)),
+			},
+			ExpectationURL:       "https://portal.example.com/",
+			ExpectationIsCaptive: true,
+		},
+		{
+			Name: "511 without url",
+			Response: &http.Response{
+				StatusCode: http.StatusNetworkAuthenticationRequired,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`<html><body>sign in</body></html>`)),
+			},
+			ExpectationURL:       "",
+			ExpectationIsCaptive: true,
+		},
+		{
+			Name: "non 511",
+			Response: &http.Response{
+				StatusCode: http.StatusFound,
+				Header:     http.Header{"Location": []string{"https://example.com/"}},
+			},
+			ExpectationURL:       "",
+			ExpectationIsCaptive: false,
+		},
+		{
+			Name:                 "nil response",
+			Response:             nil,
+			ExpectationURL:       "",
+			ExpectationIsCaptive: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualURL       string
+				actualIsCaptive bool
+			)
+
+			actualURL, actualIsCaptive = CaptivePortalHint(testCases[i].Response)
+
+			if testCases[i].ExpectationURL != actualURL {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationURL, actualURL)
+			}
+
+			if testCases[i].ExpectationIsCaptive != actualIsCaptive {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationIsCaptive, actualIsCaptive)
+			}
+		})
+	}
+}
+
+func TestCaptivePortalHintRestoresBody(t *testing.T) {
+	var (
+		body string         = `<meta http-equiv="refresh" content="0;url=https://portal.example.com/">`
+		resp *http.Response = &http.Response{
+			StatusCode: http.StatusNetworkAuthenticationRequired,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+		actual []byte
+		err    error
+	)
+
+	CaptivePortalHint(resp)
+
+	actual, err = io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("expectation is nil error, got %v", err)
+	}
+
+	if body != string(actual) {
+		t.Errorf("expectation is %q, got %q", body, actual)
+	}
+}
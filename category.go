@@ -0,0 +1,87 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// Analytics categories returned by ProblemCategory.
+const (
+	// ProblemCategorySuccess is OK.
+	ProblemCategorySuccess int = 0
+	// ProblemCategoryAuth is Unauthenticated and PermissionDenied.
+	ProblemCategoryAuth int = 1
+	// ProblemCategoryValidation is InvalidArgument, FailedPrecondition and OutOfRange.
+	ProblemCategoryValidation int = 2
+	// ProblemCategoryNotFound is NotFound.
+	ProblemCategoryNotFound int = 3
+	// ProblemCategoryConflict is AlreadyExists and Aborted.
+	ProblemCategoryConflict int = 4
+	// ProblemCategoryRate is ResourceExhausted.
+	ProblemCategoryRate int = 5
+	// ProblemCategoryServer is Canceled, Unknown, Internal, DataLoss, Unimplemented and any code without a category.
+	ProblemCategoryServer int = 6
+	// ProblemCategoryUnavailable is Unavailable and DeadlineExceeded.
+	ProblemCategoryUnavailable int = 7
+)
+
+var (
+	grpcProblemCategoryMap map[codes.Code]int = map[codes.Code]int{
+		codes.OK: ProblemCategorySuccess,
+
+		codes.Unauthenticated:  ProblemCategoryAuth,
+		codes.PermissionDenied: ProblemCategoryAuth,
+
+		codes.InvalidArgument:    ProblemCategoryValidation,
+		codes.FailedPrecondition: ProblemCategoryValidation,
+		codes.OutOfRange:         ProblemCategoryValidation,
+
+		codes.NotFound: ProblemCategoryNotFound,
+
+		codes.AlreadyExists: ProblemCategoryConflict,
+		codes.Aborted:       ProblemCategoryConflict,
+
+		codes.ResourceExhausted: ProblemCategoryRate,
+
+		codes.Canceled:      ProblemCategoryServer,
+		codes.Unknown:       ProblemCategoryServer,
+		codes.Internal:      ProblemCategoryServer,
+		codes.DataLoss:      ProblemCategoryServer,
+		codes.Unimplemented: ProblemCategoryServer,
+
+		codes.Unavailable:      ProblemCategoryUnavailable,
+		codes.DeadlineExceeded: ProblemCategoryUnavailable,
+	}
+)
+
+// WithProblemCategoryForGRPCCode overrides the category ProblemCategory returns for grpcCode.
+func WithProblemCategoryForGRPCCode(grpcCode codes.Code, category int) Option {
+	return func(s *converterState) {
+		s.problemCategories[grpcCode] = category
+	}
+}
+
+// ProblemCategory returns the numeric analytics category of grpcCode, one of the ProblemCategory constants,
+// unless overridden with WithProblemCategoryForGRPCCode. Codes without a category fall in ProblemCategoryServer.
+func (c *Converter) ProblemCategory(grpcCode codes.Code) int {
+	var (
+		category int
+		ok       bool
+	)
+
+	category, ok = c.state.Load().problemCategories[grpcCode]
+	if ok {
+		return category
+	}
+
+	category, ok = grpcProblemCategoryMap[grpcCode]
+	if !ok {
+		return ProblemCategoryServer
+	}
+
+	return category
+}
+
+// ProblemCategoryFromGRPCCode is (*Converter).ProblemCategory on the default converter.
+func ProblemCategoryFromGRPCCode(grpcCode codes.Code) int {
+	return defaultConverter.ProblemCategory(grpcCode)
+}
@@ -0,0 +1,97 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestProblemCategoryFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: 0,
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: 1,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: 1,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: 2,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: 3,
+		},
+		{
+			Name:        codes.AlreadyExists.String(),
+			GRPCCode:    codes.AlreadyExists,
+			Expectation: 4,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: 5,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: 6,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: 7,
+		},
+		{
+			Name:        codes.Code(999).String(),
+			GRPCCode:    codes.Code(999),
+			Expectation: 6,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = ProblemCategoryFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithProblemCategoryForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithProblemCategoryForGRPCCode(codes.Aborted, ProblemCategoryUnavailable))
+		actual int        = c.ProblemCategory(codes.Aborted)
+	)
+
+	if actual != ProblemCategoryUnavailable {
+		t.Errorf("expectation is %d, got %d", ProblemCategoryUnavailable, actual)
+	}
+
+	actual = ProblemCategoryFromGRPCCode(codes.Aborted)
+
+	if actual != ProblemCategoryConflict {
+		t.Errorf("expectation is %d, got %d", ProblemCategoryConflict, actual)
+	}
+}
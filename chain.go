@@ -0,0 +1,45 @@
+package gostacode
+
+// ChainConverters returns a Converter that looks a code up in each of converters in turn
+// and uses the first mapping table with an entry for it, such as a tenant-specific converter over a base preset.
+// Only explicit mapping table entries cascade: a code missing from every table resolves
+// through the fallbacks and other settings of the first converter, never through those of a later one.
+// The mapping tables are combined as they are when ChainConverters is called,
+// so later overrides on converters do not affect the returned Converter.
+// With no converters, it returns NewConverter().
+func ChainConverters(converters ...*Converter) *Converter {
+	if len(converters) == 0 {
+		return NewConverter()
+	}
+
+	var (
+		c *Converter      = &Converter{}
+		s *converterState = converters[0].state.Load().clone()
+	)
+
+	for i := 1; i < len(converters); i++ {
+		var next *converterState = converters[i].state.Load()
+
+		for httpStatusCode, grpcCode := range next.httpToGRPC {
+			var ok bool
+
+			_, ok = s.httpToGRPC[httpStatusCode]
+			if !ok {
+				s.setHTTPToGRPC(httpStatusCode, grpcCode)
+			}
+		}
+
+		for grpcCode, httpStatusCode := range next.grpcToHTTP {
+			var ok bool
+
+			_, ok = s.grpcToHTTP[grpcCode]
+			if !ok {
+				s.grpcToHTTP[grpcCode] = httpStatusCode
+			}
+		}
+	}
+
+	c.state.Store(s)
+
+	return c
+}
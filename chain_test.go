@@ -0,0 +1,89 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestChainConverters(t *testing.T) {
+	var (
+		tenant *Converter = newConverter(
+			map[int]codes.Code{http.StatusConflict: codes.Aborted},
+			map[codes.Code]int{codes.Aborted: http.StatusConflict},
+		)
+		base  *Converter = NewConverter()
+		chain *Converter = ChainConverters(tenant, base)
+	)
+
+	var testCases []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	} = []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	}{
+		{
+			Name:                "missing in the first converter",
+			HTTPStatusCode:      http.StatusNotFound,
+			ExpectationGRPCCode: codes.NotFound,
+			ExpectationOK:       true,
+		},
+		{
+			Name:                "mapped by the first converter",
+			HTTPStatusCode:      http.StatusConflict,
+			ExpectationGRPCCode: codes.Aborted,
+			ExpectationOK:       true,
+		},
+		{
+			Name:                "missing in every converter",
+			HTTPStatusCode:      999,
+			ExpectationGRPCCode: codes.Unknown,
+			ExpectationOK:       false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual   codes.Code
+				actualOK bool
+			)
+
+			actual, actualOK = chain.GRPCCodeOK(testCases[i].HTTPStatusCode)
+
+			if testCases[i].ExpectationGRPCCode != actual || testCases[i].ExpectationOK != actualOK {
+				t.Errorf("expectation is (%s, %t), got (%s, %t)", testCases[i].ExpectationGRPCCode, testCases[i].ExpectationOK, actual, actualOK)
+			}
+		})
+	}
+
+	var actual int = chain.HTTPStatusCode(codes.NotFound)
+
+	if actual != http.StatusNotFound {
+		t.Errorf("expectation is %d, got %d", http.StatusNotFound, actual)
+	}
+
+	actual = chain.HTTPStatusCode(codes.Aborted)
+
+	if actual != http.StatusConflict {
+		t.Errorf("expectation is %d, got %d", http.StatusConflict, actual)
+	}
+}
+
+func TestChainConvertersFallbackDoesNotCascade(t *testing.T) {
+	var (
+		first  *Converter = NewConverter()
+		second *Converter = NewConverter(WithKnownUnmapped(http.StatusTeapot))
+		actual codes.Code = ChainConverters(first, second).GRPCCode(http.StatusTeapot)
+	)
+
+	if actual != codes.Unknown {
+		t.Errorf("expectation is %s, got %s", codes.Unknown, actual)
+	}
+}
@@ -0,0 +1,41 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// Outcome is how a circuit breaker should count a call.
+type Outcome int
+
+const (
+	// OutcomeSuccess counts the call as a success.
+	OutcomeSuccess Outcome = iota
+	// OutcomeFailure counts the call toward tripping the breaker.
+	OutcomeFailure
+	// OutcomeIgnore leaves the call out of the breaker's counts.
+	OutcomeIgnore
+)
+
+var (
+	circuitBreakerFailureGRPCCodes map[codes.Code]bool = map[codes.Code]bool{
+		codes.Unknown:          true,
+		codes.DeadlineExceeded: true,
+		codes.Internal:         true,
+		codes.Unavailable:      true,
+		codes.DataLoss:         true,
+	}
+)
+
+// CircuitBreakerOutcome returns how a circuit breaker should count a call that ended with grpcCode.
+// OK is a success, codes that signal an unhealthy dependency are failures that count toward tripping,
+// and every other code, including client errors, is ignored.
+func CircuitBreakerOutcome(grpcCode codes.Code) Outcome {
+	switch {
+	case grpcCode == codes.OK:
+		return OutcomeSuccess
+	case circuitBreakerFailureGRPCCodes[grpcCode]:
+		return OutcomeFailure
+	default:
+		return OutcomeIgnore
+	}
+}
@@ -0,0 +1,85 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCircuitBreakerOutcome(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation Outcome
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation Outcome
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: OutcomeSuccess,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: OutcomeFailure,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: OutcomeFailure,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: OutcomeFailure,
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: OutcomeFailure,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: OutcomeFailure,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: OutcomeIgnore,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: OutcomeIgnore,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: OutcomeIgnore,
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: OutcomeIgnore,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: OutcomeIgnore,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual Outcome = CircuitBreakerOutcome(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
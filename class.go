@@ -0,0 +1,29 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// IsClientError reports whether grpcCode maps to a 4xx HTTP status code.
+func IsClientError(grpcCode codes.Code) bool {
+	var (
+		httpStatusCode int
+		ok             bool
+	)
+
+	httpStatusCode, ok = HTTPStatusCodeFromGRPCCodeOK(grpcCode)
+
+	return ok && httpStatusCode >= 400 && httpStatusCode <= 499
+}
+
+// IsServerError reports whether grpcCode maps to a 5xx HTTP status code.
+func IsServerError(grpcCode codes.Code) bool {
+	var (
+		httpStatusCode int
+		ok             bool
+	)
+
+	httpStatusCode, ok = HTTPStatusCodeFromGRPCCodeOK(grpcCode)
+
+	return ok && httpStatusCode >= 500 && httpStatusCode <= 599
+}
@@ -0,0 +1,157 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsClientErrorIsServerError(t *testing.T) {
+	var testCases []struct {
+		Name              string
+		GRPCCode          codes.Code
+		ExpectationClient bool
+		ExpectationServer bool
+	} = []struct {
+		Name              string
+		GRPCCode          codes.Code
+		ExpectationClient bool
+		ExpectationServer bool
+	}{
+		{
+			Name:              codes.OK.String(),
+			GRPCCode:          codes.OK,
+			ExpectationClient: false,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.InvalidArgument.String(),
+			GRPCCode:          codes.InvalidArgument,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.NotFound.String(),
+			GRPCCode:          codes.NotFound,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.AlreadyExists.String(),
+			GRPCCode:          codes.AlreadyExists,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.PermissionDenied.String(),
+			GRPCCode:          codes.PermissionDenied,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.Unauthenticated.String(),
+			GRPCCode:          codes.Unauthenticated,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.FailedPrecondition.String(),
+			GRPCCode:          codes.FailedPrecondition,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.OutOfRange.String(),
+			GRPCCode:          codes.OutOfRange,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.ResourceExhausted.String(),
+			GRPCCode:          codes.ResourceExhausted,
+			ExpectationClient: true,
+			ExpectationServer: false,
+		},
+		{
+			Name:              codes.Internal.String(),
+			GRPCCode:          codes.Internal,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              codes.Unknown.String(),
+			GRPCCode:          codes.Unknown,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              codes.DataLoss.String(),
+			GRPCCode:          codes.DataLoss,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              codes.Unimplemented.String(),
+			GRPCCode:          codes.Unimplemented,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              codes.Unavailable.String(),
+			GRPCCode:          codes.Unavailable,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              codes.DeadlineExceeded.String(),
+			GRPCCode:          codes.DeadlineExceeded,
+			ExpectationClient: false,
+			ExpectationServer: true,
+		},
+		{
+			Name:              "unmapped",
+			GRPCCode:          codes.Code(99),
+			ExpectationClient: false,
+			ExpectationServer: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualClient bool = IsClientError(testCases[i].GRPCCode)
+				actualServer bool = IsServerError(testCases[i].GRPCCode)
+			)
+
+			if testCases[i].ExpectationClient != actualClient {
+				t.Errorf("expectation client is %t, got %t", testCases[i].ExpectationClient, actualClient)
+			}
+
+			if testCases[i].ExpectationServer != actualServer {
+				t.Errorf("expectation server is %t, got %t", testCases[i].ExpectationServer, actualServer)
+			}
+		})
+	}
+}
+
+func TestIsClientErrorIsServerErrorExclusive(t *testing.T) {
+	for grpcCode := range grpcHTTPCodeMap {
+		var classes int
+
+		if grpcCode == codes.OK {
+			classes++
+		}
+
+		if IsClientError(grpcCode) {
+			classes++
+		}
+
+		if IsServerError(grpcCode) {
+			classes++
+		}
+
+		if classes != 1 {
+			t.Errorf("%s: expectation is exactly one class, got %d", grpcCode, classes)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// StatusClientClosedRequest is the non-standard 499 Client Closed Request status used by nginx and grpc-gateway
+// when the client went away before the response was sent. http.StatusText returns an empty string for it.
+const StatusClientClosedRequest int = 499
+
+// WithClientClosedRequest maps Canceled to StatusClientClosedRequest instead of 500 Internal Server Error.
+func WithClientClosedRequest() Option {
+	return func(s *converterState) {
+		s.grpcToHTTP[codes.Canceled] = StatusClientClosedRequest
+	}
+}
+
+// HTTPStatusCodeFromGRPCCodeNginx is HTTPStatusCodeFromGRPCCode with Canceled mapped to StatusClientClosedRequest,
+// as nginx does.
+func HTTPStatusCodeFromGRPCCodeNginx(grpcCode codes.Code) int {
+	return nginxConverter.HTTPStatusCode(grpcCode)
+}
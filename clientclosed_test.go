@@ -0,0 +1,82 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatusCodeFromGRPCCodeNginx(t *testing.T) {
+	var testCases []struct {
+		Name            string
+		GRPCCode        codes.Code
+		Expectation     int
+		ExpectationText string
+	} = []struct {
+		Name            string
+		GRPCCode        codes.Code
+		Expectation     int
+		ExpectationText string
+	}{
+		{
+			Name:            codes.Canceled.String(),
+			GRPCCode:        codes.Canceled,
+			Expectation:     StatusClientClosedRequest,
+			ExpectationText: "",
+		},
+		{
+			Name:            codes.NotFound.String(),
+			GRPCCode:        codes.NotFound,
+			Expectation:     http.StatusNotFound,
+			ExpectationText: "Not Found",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusCodeFromGRPCCodeNginx(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+
+			if testCases[i].ExpectationText != http.StatusText(actual) {
+				t.Errorf("expectation text is %q, got %q", testCases[i].ExpectationText, http.StatusText(actual))
+			}
+		})
+	}
+}
+
+func TestWithClientClosedRequest(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Converter   *Converter
+		Expectation int
+	} = []struct {
+		Name        string
+		Converter   *Converter
+		Expectation int
+	}{
+		{
+			Name:        "with option",
+			Converter:   NewConverter(WithClientClosedRequest()),
+			Expectation: StatusClientClosedRequest,
+		},
+		{
+			Name:        "without option",
+			Converter:   NewConverter(),
+			Expectation: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = testCases[i].Converter.HTTPStatusCode(codes.Canceled)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// grpcCoAPCodeMap follows the response codes of RFC 7252 section 5.9,
+	// with 4.29 Too Many Requests from RFC 8516.
+	grpcCoAPCodeMap map[codes.Code]string = map[codes.Code]string{
+		codes.OK:                 "2.05 Content",
+		codes.InvalidArgument:    "4.00 Bad Request",
+		codes.Unauthenticated:    "4.01 Unauthorized",
+		codes.PermissionDenied:   "4.03 Forbidden",
+		codes.NotFound:           "4.04 Not Found",
+		codes.FailedPrecondition: "4.12 Precondition Failed",
+		codes.ResourceExhausted:  "4.29 Too Many Requests",
+		codes.Unknown:            "5.00 Internal Server Error",
+		codes.Internal:           "5.00 Internal Server Error",
+		codes.Unimplemented:      "5.01 Not Implemented",
+		codes.Unavailable:        "5.03 Service Unavailable",
+		codes.DeadlineExceeded:   "5.04 Gateway Timeout",
+	}
+)
+
+// CoAPCodeFromGRPCCode returns the CoAP response code, with its reason phrase, for grpcCode.
+// Codes without a CoAP counterpart map to "5.00 Internal Server Error".
+func CoAPCodeFromGRPCCode(grpcCode codes.Code) string {
+	var (
+		coapCode string
+		ok       bool
+	)
+
+	coapCode, ok = grpcCoAPCodeMap[grpcCode]
+	if !ok {
+		return "5.00 Internal Server Error"
+	}
+
+	return coapCode
+}
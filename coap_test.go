@@ -0,0 +1,75 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCoAPCodeFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: "2.05 Content",
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: "4.04 Not Found",
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: "4.03 Forbidden",
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: "4.01 Unauthorized",
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: "4.29 Too Many Requests",
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: "5.03 Service Unavailable",
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: "5.00 Internal Server Error",
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: "5.00 Internal Server Error",
+		},
+		{
+			Name:        codes.Canceled.String(),
+			GRPCCode:    codes.Canceled,
+			Expectation: "5.00 Internal Server Error",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = CoAPCodeFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	closeConnectionGRPCCodes map[codes.Code]bool = map[codes.Code]bool{
+		codes.Unknown:     true,
+		codes.Internal:    true,
+		codes.Unavailable: true,
+		codes.DataLoss:    true,
+	}
+)
+
+// ShouldCloseConnection reports whether a proxy should close the HTTP/1.1 connection
+// after a response derived from grpcCode, so a possibly broken backend connection is not reused.
+func ShouldCloseConnection(grpcCode codes.Code) bool {
+	return closeConnectionGRPCCodes[grpcCode]
+}
@@ -0,0 +1,65 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestShouldCloseConnection(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: true,
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: true,
+		},
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: false,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: false,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = ShouldCloseConnection(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
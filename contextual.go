@@ -0,0 +1,31 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// WithContextualGRPCFallback sets the codes GRPCCodeContextual resolves unmapped HTTP status codes to,
+// on the client side and on the server side. Both default to codes.Unknown.
+func WithContextualGRPCFallback(clientSide codes.Code, serverSide codes.Code) Option {
+	return func(s *converterState) {
+		s.clientSideGRPCFallback = clientSide
+		s.serverSideGRPCFallback = serverSide
+	}
+}
+
+// GRPCCodeContextual maps httpStatusCode like GRPCCode, but resolves unmapped codes to the client-side
+// or server-side fallback configured with WithContextualGRPCFallback.
+func (c *Converter) GRPCCodeContextual(httpStatusCode int, serverSide bool) codes.Code {
+	var s *converterState = c.state.Load()
+
+	if serverSide {
+		return s.grpcCodeOrDefault(httpStatusCode, s.serverSideGRPCFallback)
+	}
+
+	return s.grpcCodeOrDefault(httpStatusCode, s.clientSideGRPCFallback)
+}
+
+// GRPCCodeFromHTTPStatusCodeContextual is (*Converter).GRPCCodeContextual on the default converter.
+func GRPCCodeFromHTTPStatusCodeContextual(httpStatusCode int, serverSide bool) codes.Code {
+	return defaultConverter.GRPCCodeContextual(httpStatusCode, serverSide)
+}
@@ -0,0 +1,86 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestConverterGRPCCodeContextual(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		ServerSide     bool
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		ServerSide     bool
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "default client side",
+			Converter:      NewConverter(),
+			HTTPStatusCode: http.StatusTeapot,
+			ServerSide:     false,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "default server side",
+			Converter:      NewConverter(),
+			HTTPStatusCode: http.StatusTeapot,
+			ServerSide:     true,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "configured client side",
+			Converter:      NewConverter(WithContextualGRPCFallback(codes.Unavailable, codes.Internal)),
+			HTTPStatusCode: http.StatusTeapot,
+			ServerSide:     false,
+			Expectation:    codes.Unavailable,
+		},
+		{
+			Name:           "configured server side",
+			Converter:      NewConverter(WithContextualGRPCFallback(codes.Unavailable, codes.Internal)),
+			HTTPStatusCode: http.StatusTeapot,
+			ServerSide:     true,
+			Expectation:    codes.Internal,
+		},
+		{
+			Name:           "mapped ignores fallback",
+			Converter:      NewConverter(WithContextualGRPCFallback(codes.Unavailable, codes.Internal)),
+			HTTPStatusCode: http.StatusNotFound,
+			ServerSide:     true,
+			Expectation:    codes.NotFound,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = testCases[i].Converter.GRPCCodeContextual(testCases[i].HTTPStatusCode, testCases[i].ServerSide)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestGRPCCodeFromHTTPStatusCodeContextual(t *testing.T) {
+	var previous *converterState = defaultConverter.state.Load()
+
+	defer defaultConverter.state.Store(previous)
+
+	if codes.Unknown != GRPCCodeFromHTTPStatusCodeContextual(http.StatusTeapot, true) {
+		t.Errorf("expectation is %d, got %d", codes.Unknown, GRPCCodeFromHTTPStatusCodeContextual(http.StatusTeapot, true))
+	}
+
+	Configure(WithContextualGRPCFallback(codes.Unavailable, codes.Internal))
+
+	if codes.Internal != GRPCCodeFromHTTPStatusCodeContextual(http.StatusTeapot, true) {
+		t.Errorf("expectation is %d, got %d", codes.Internal, GRPCCodeFromHTTPStatusCodeContextual(http.StatusTeapot, true))
+	}
+}
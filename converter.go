@@ -0,0 +1,434 @@
+package gostacode
+
+import (
+	"log/slog"
+	"maps"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// httpToGRPCTableSize bounds the array lookup table, which covers the 1xx to 5xx status codes.
+	httpToGRPCTableSize int = 600
+	// unmappedGRPCCode marks a status code without a mapping in the array lookup table.
+	unmappedGRPCCode codes.Code = codes.Code(^uint32(0))
+)
+
+// Converter converts between HTTP status codes and gRPC codes using its own mapping tables,
+// so services with different conventions can live in the same process.
+// A Converter is safe for concurrent use. Lookups read an immutable snapshot of its state without locking,
+// and overrides publish a modified copy, so a lookup never observes a partially applied override.
+type Converter struct {
+	// mu serializes overrides, so concurrent overrides do not lose each other's changes.
+	mu    sync.Mutex
+	state atomic.Pointer[converterState]
+}
+
+// converterState holds the mapping tables and settings of a Converter.
+// It is never modified once published; overrides replace it with a modified clone.
+type converterState struct {
+	httpToGRPC map[int]codes.Code
+	grpcToHTTP map[codes.Code]int
+	deprecated map[codes.Code]string
+
+	// httpToGRPCTable mirrors httpToGRPC for status codes below httpToGRPCTableSize,
+	// so the common lookups avoid hashing.
+	httpToGRPCTable [httpToGRPCTableSize]codes.Code
+
+	successStatus map[string]int
+	knownUnmapped map[int]bool
+	// knownUnmappedGRPCCode is the fallback of the known unmapped codes,
+	// or unmappedGRPCCode to pick it by their class.
+	knownUnmappedGRPCCode codes.Code
+
+	// disableSuccessClassFallback stops unmapped 2xx codes from resolving to codes.OK.
+	disableSuccessClassFallback bool
+
+	grpcToCustomCode map[codes.Code]int
+	customCodeToGRPC map[int]codes.Code
+
+	clientSideGRPCFallback codes.Code
+	serverSideGRPCFallback codes.Code
+
+	mixedBatchHTTPStatusCode int
+
+	logLevels           map[codes.Code]slog.Level
+	sdkExceptionClasses map[string]map[codes.Code]string
+	sloErrorGRPCCodes   map[codes.Code]bool
+	cacheControls       map[codes.Code]string
+	healthIndicators    map[codes.Code]string
+	recoveryHints       map[codes.Code]string
+	problemCategories   map[codes.Code]int
+	errorPageTemplates  map[codes.Code]string
+	errorTitles         map[Tone]map[codes.Code]string
+}
+
+// Option configures a Converter.
+type Option func(*converterState)
+
+// WithKnownUnmapped marks httpStatusCodes as deliberately left unmapped, as opposed to never expected.
+// GRPCCode resolves them through their class, so an unmapped 5xx becomes codes.Internal
+// and any other known code, such as 418 or 302, becomes codes.InvalidArgument,
+// while unmapped inputs that are not known, such as 999, still become codes.Unknown.
+// Use WithKnownUnmappedFallback to resolve every known code to the same gRPC code instead.
+func WithKnownUnmapped(httpStatusCodes ...int) Option {
+	return func(s *converterState) {
+		for i := range httpStatusCodes {
+			s.knownUnmapped[httpStatusCodes[i]] = true
+		}
+	}
+}
+
+// WithKnownUnmappedFallback makes GRPCCode resolve the codes registered with WithKnownUnmapped to grpcCode,
+// whatever their class.
+func WithKnownUnmappedFallback(grpcCode codes.Code) Option {
+	return func(s *converterState) {
+		s.knownUnmappedGRPCCode = grpcCode
+	}
+}
+
+// NewConverter returns a Converter seeded with a copy of the standard mappings.
+// Overrides on the returned Converter never affect other converters or the package-level functions.
+func NewConverter(opts ...Option) *Converter {
+	return newConverter(httpGRPCCodeMap, grpcHTTPCodeMap, opts...)
+}
+
+// newConverter returns a Converter seeded with a copy of httpToGRPC and grpcToHTTP.
+func newConverter(httpToGRPC map[int]codes.Code, grpcToHTTP map[codes.Code]int, opts ...Option) *Converter {
+	var (
+		c *Converter      = &Converter{}
+		s *converterState = &converterState{
+			httpToGRPC: make(map[int]codes.Code, len(httpToGRPC)),
+			grpcToHTTP: maps.Clone(grpcToHTTP),
+			deprecated: map[codes.Code]string{},
+
+			successStatus: maps.Clone(methodSuccessStatusMap),
+			knownUnmapped: map[int]bool{},
+
+			knownUnmappedGRPCCode: unmappedGRPCCode,
+
+			clientSideGRPCFallback: codes.Unknown,
+			serverSideGRPCFallback: codes.Unknown,
+
+			mixedBatchHTTPStatusCode: http.StatusMultiStatus,
+
+			logLevels: map[codes.Code]slog.Level{},
+
+			sdkExceptionClasses: map[string]map[codes.Code]string{},
+			sloErrorGRPCCodes:   map[codes.Code]bool{},
+			cacheControls:       map[codes.Code]string{},
+			healthIndicators:    map[codes.Code]string{},
+			recoveryHints:       map[codes.Code]string{},
+			problemCategories:   map[codes.Code]int{},
+			errorPageTemplates:  map[codes.Code]string{},
+			errorTitles:         map[Tone]map[codes.Code]string{},
+		}
+	)
+
+	for i := range s.httpToGRPCTable {
+		s.httpToGRPCTable[i] = unmappedGRPCCode
+	}
+
+	for httpStatusCode, grpcCode := range httpToGRPC {
+		s.setHTTPToGRPC(httpStatusCode, grpcCode)
+	}
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	c.state.Store(s)
+
+	return c
+}
+
+// Configure applies opts to c. The options are applied together,
+// so concurrent lookups observe either none or all of them.
+func (c *Converter) Configure(opts ...Option) {
+	c.update(opts...)
+}
+
+// clone returns a new Converter starting from the current state of c.
+// Both converters share the published state, which is safe since overrides never modify it.
+func (c *Converter) clone() *Converter {
+	var clone *Converter = &Converter{}
+
+	clone.state.Store(c.state.Load())
+
+	return clone
+}
+
+// clone returns a deep copy of s that can be modified before being published.
+func (s *converterState) clone() *converterState {
+	var clone converterState = *s
+
+	clone.httpToGRPC = maps.Clone(s.httpToGRPC)
+	clone.grpcToHTTP = maps.Clone(s.grpcToHTTP)
+	clone.deprecated = maps.Clone(s.deprecated)
+	clone.successStatus = maps.Clone(s.successStatus)
+	clone.knownUnmapped = maps.Clone(s.knownUnmapped)
+	clone.grpcToCustomCode = maps.Clone(s.grpcToCustomCode)
+	clone.customCodeToGRPC = maps.Clone(s.customCodeToGRPC)
+	clone.logLevels = maps.Clone(s.logLevels)
+	clone.sloErrorGRPCCodes = maps.Clone(s.sloErrorGRPCCodes)
+	clone.cacheControls = maps.Clone(s.cacheControls)
+	clone.healthIndicators = maps.Clone(s.healthIndicators)
+	clone.recoveryHints = maps.Clone(s.recoveryHints)
+	clone.problemCategories = maps.Clone(s.problemCategories)
+	clone.errorPageTemplates = maps.Clone(s.errorPageTemplates)
+	clone.sdkExceptionClasses = make(map[string]map[codes.Code]string, len(s.sdkExceptionClasses))
+
+	for lang, classes := range s.sdkExceptionClasses {
+		clone.sdkExceptionClasses[lang] = maps.Clone(classes)
+	}
+
+	clone.errorTitles = make(map[Tone]map[codes.Code]string, len(s.errorTitles))
+
+	for tone, titles := range s.errorTitles {
+		clone.errorTitles[tone] = maps.Clone(titles)
+	}
+
+	return &clone
+}
+
+// update publishes a clone of the state of c modified by opts.
+func (c *Converter) update(opts ...Option) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var s *converterState = c.state.Load().clone()
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	c.state.Store(s)
+}
+
+// mappedGRPCCode returns the gRPC code mapped from httpStatusCode and whether the mapping table has an entry for it.
+func (s *converterState) mappedGRPCCode(httpStatusCode int) (codes.Code, bool) {
+	var grpcCode codes.Code
+
+	if httpStatusCode >= 0 && httpStatusCode < httpToGRPCTableSize {
+		grpcCode = s.httpToGRPCTable[httpStatusCode]
+	} else {
+		grpcCode = LookupOrDefault(s.httpToGRPC, httpStatusCode, unmappedGRPCCode)
+	}
+
+	if grpcCode == unmappedGRPCCode {
+		return codes.Unknown, false
+	}
+
+	return grpcCode, true
+}
+
+// fallbackGRPCCode returns the gRPC code an httpStatusCode missing from the mapping table resolves to:
+// codes.OK for a 2xx code, unless the success class rule is disabled, and fallback otherwise.
+func (s *converterState) fallbackGRPCCode(httpStatusCode int, fallback codes.Code) codes.Code {
+	if !s.disableSuccessClassFallback && httpStatusCode >= 200 && httpStatusCode <= 299 {
+		return codes.OK
+	}
+
+	return fallback
+}
+
+// grpcCodeOrDefault returns the result of GRPCCodeOrDefault against s.
+func (s *converterState) grpcCodeOrDefault(httpStatusCode int, fallback codes.Code) codes.Code {
+	var (
+		grpcCode codes.Code
+		ok       bool
+	)
+
+	grpcCode, ok = s.mappedGRPCCode(httpStatusCode)
+	if ok {
+		return grpcCode
+	}
+
+	return s.fallbackGRPCCode(httpStatusCode, fallback)
+}
+
+// grpcCode returns the result of GRPCCodeOK against s.
+func (s *converterState) grpcCode(httpStatusCode int) (codes.Code, bool) {
+	var (
+		grpcCode codes.Code
+		ok       bool
+	)
+
+	grpcCode, ok = s.mappedGRPCCode(httpStatusCode)
+	if ok {
+		return grpcCode, true
+	}
+
+	if s.knownUnmapped[httpStatusCode] {
+		return s.fallbackGRPCCode(httpStatusCode, s.knownUnmappedFallback(httpStatusCode)), false
+	}
+
+	return s.fallbackGRPCCode(httpStatusCode, codes.Unknown), false
+}
+
+// knownUnmappedFallback returns the gRPC code a known unmapped httpStatusCode falls back to.
+func (s *converterState) knownUnmappedFallback(httpStatusCode int) codes.Code {
+	if s.knownUnmappedGRPCCode != unmappedGRPCCode {
+		return s.knownUnmappedGRPCCode
+	}
+
+	if httpStatusCode >= 500 && httpStatusCode <= 599 {
+		return codes.Internal
+	}
+
+	return codes.InvalidArgument
+}
+
+// GRPCCodeOK returns the gRPC code GRPCCode returns for httpStatusCode
+// and whether the lookup hit the mapping table. When it did not, the code is the one GRPCCode resolves to
+// without a mapping, such as codes.OK for an unmapped 2xx code and codes.Unknown for 999.
+func (c *Converter) GRPCCodeOK(httpStatusCode int) (codes.Code, bool) {
+	return c.state.Load().grpcCode(httpStatusCode)
+}
+
+// HTTPStatusCodeOK returns the HTTP status code mapped from grpcCode
+// and whether the mapping table has an entry for it.
+// When it does not, it returns http.StatusInternalServerError and false.
+func (c *Converter) HTTPStatusCodeOK(grpcCode codes.Code) (int, bool) {
+	var (
+		httpStatusCode int
+		ok             bool
+	)
+
+	httpStatusCode, ok = c.state.Load().grpcToHTTP[grpcCode]
+	if !ok {
+		return http.StatusInternalServerError, false
+	}
+
+	return httpStatusCode, true
+}
+
+// GRPCCodeOrDefault returns the gRPC code mapped from httpStatusCode.
+// Unmapped 2xx codes resolve to codes.OK, except on a LegacyConverter predating that rule,
+// and any other unmapped code resolves to fallback.
+func (c *Converter) GRPCCodeOrDefault(httpStatusCode int, fallback codes.Code) codes.Code {
+	return c.state.Load().grpcCodeOrDefault(httpStatusCode, fallback)
+}
+
+// HTTPStatusCodeOrDefault returns the HTTP status code mapped from grpcCode,
+// or fallback when grpcCode is unmapped.
+func (c *Converter) HTTPStatusCodeOrDefault(grpcCode codes.Code, fallback int) int {
+	return LookupOrDefault(c.state.Load().grpcToHTTP, grpcCode, fallback)
+}
+
+// GRPCCode returns the gRPC code mapped from httpStatusCode, falling back to codes.Unknown.
+// Codes registered with WithKnownUnmapped fall back as described there instead.
+func (c *Converter) GRPCCode(httpStatusCode int) codes.Code {
+	var grpcCode codes.Code
+
+	grpcCode, _ = c.GRPCCodeOK(httpStatusCode)
+
+	return grpcCode
+}
+
+// GRPCCodeWithRanges returns the gRPC code mapped from httpStatusCode like GRPCCode,
+// except that unmapped 4xx codes resolve to codes.InvalidArgument and unmapped 5xx codes to codes.Internal.
+func (c *Converter) GRPCCodeWithRanges(httpStatusCode int) codes.Code {
+	return c.state.Load().grpcCodeOrDefault(httpStatusCode, rangeFallbackGRPCCode(httpStatusCode))
+}
+
+// rangeFallbackGRPCCode returns the gRPC code for the class of httpStatusCode:
+// codes.InvalidArgument for 4xx, codes.Internal for 5xx and codes.Unknown otherwise.
+func rangeFallbackGRPCCode(httpStatusCode int) codes.Code {
+	switch {
+	case httpStatusCode >= 400 && httpStatusCode <= 499:
+		return codes.InvalidArgument
+	case httpStatusCode >= 500 && httpStatusCode <= 599:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// HTTPStatusCode returns the HTTP status code mapped from grpcCode, falling back to http.StatusInternalServerError.
+func (c *Converter) HTTPStatusCode(grpcCode codes.Code) int {
+	return c.HTTPStatusCodeOrDefault(grpcCode, http.StatusInternalServerError)
+}
+
+// SetHTTPToGRPC maps httpStatusCode to grpcCode. The gRPC to HTTP direction is left untouched;
+// use SetBidirectional to update both.
+func (c *Converter) SetHTTPToGRPC(httpStatusCode int, grpcCode codes.Code) {
+	c.update(func(s *converterState) {
+		s.setHTTPToGRPC(httpStatusCode, grpcCode)
+	})
+}
+
+// setHTTPToGRPC maps httpStatusCode to grpcCode in both the mapping table and the array lookup table.
+func (s *converterState) setHTTPToGRPC(httpStatusCode int, grpcCode codes.Code) {
+	s.httpToGRPC[httpStatusCode] = grpcCode
+
+	if httpStatusCode >= 0 && httpStatusCode < httpToGRPCTableSize {
+		s.httpToGRPCTable[httpStatusCode] = grpcCode
+	}
+}
+
+// deleteHTTPToGRPC removes the mapping of httpStatusCode from both the mapping table and the array lookup table.
+func (s *converterState) deleteHTTPToGRPC(httpStatusCode int) {
+	delete(s.httpToGRPC, httpStatusCode)
+
+	if httpStatusCode >= 0 && httpStatusCode < httpToGRPCTableSize {
+		s.httpToGRPCTable[httpStatusCode] = unmappedGRPCCode
+	}
+}
+
+// SetGRPCToHTTP maps grpcCode to httpStatusCode. The HTTP to gRPC direction is left untouched;
+// use SetBidirectional to update both.
+func (c *Converter) SetGRPCToHTTP(grpcCode codes.Code, httpStatusCode int) {
+	c.update(func(s *converterState) {
+		s.grpcToHTTP[grpcCode] = httpStatusCode
+	})
+}
+
+// SetBidirectional maps httpStatusCode to grpcCode and grpcCode back to httpStatusCode atomically,
+// so concurrent lookups never see one direction updated without the other.
+// When several HTTP status codes are mapped to the same gRPC code, the last SetBidirectional call wins
+// the gRPC to HTTP direction. Later one-directional SetHTTPToGRPC or SetGRPCToHTTP calls override
+// their own direction only, so they can break the symmetry on purpose.
+func (c *Converter) SetBidirectional(httpStatusCode int, grpcCode codes.Code) {
+	c.update(func(s *converterState) {
+		s.setHTTPToGRPC(httpStatusCode, grpcCode)
+		s.grpcToHTTP[grpcCode] = httpStatusCode
+	})
+}
+
+// RangeHTTPToGRPC calls fn sequentially for each HTTP to gRPC mapping.
+// If fn returns false, RangeHTTPToGRPC stops the iteration.
+// It iterates over the mappings as they were when it was called, without copying them,
+// so fn may override mappings of c without affecting the iteration.
+func (c *Converter) RangeHTTPToGRPC(fn func(httpStatusCode int, grpcCode codes.Code) bool) {
+	for httpStatusCode, grpcCode := range c.state.Load().httpToGRPC {
+		if !fn(httpStatusCode, grpcCode) {
+			return
+		}
+	}
+}
+
+// RangeGRPCToHTTP calls fn sequentially for each gRPC to HTTP mapping.
+// If fn returns false, RangeGRPCToHTTP stops the iteration.
+// It iterates over the mappings as they were when it was called, without copying them,
+// so fn may override mappings of c without affecting the iteration.
+func (c *Converter) RangeGRPCToHTTP(fn func(grpcCode codes.Code, httpStatusCode int) bool) {
+	for grpcCode, httpStatusCode := range c.state.Load().grpcToHTTP {
+		if !fn(grpcCode, httpStatusCode) {
+			return
+		}
+	}
+}
+
+// HTTPToGRPCMappings returns a copy of the HTTP to gRPC mapping table.
+func (c *Converter) HTTPToGRPCMappings() map[int]codes.Code {
+	return maps.Clone(c.state.Load().httpToGRPC)
+}
+
+// GRPCToHTTPMappings returns a copy of the gRPC to HTTP mapping table.
+func (c *Converter) GRPCToHTTPMappings() map[codes.Code]int {
+	return maps.Clone(c.state.Load().grpcToHTTP)
+}
@@ -0,0 +1,566 @@
+package gostacode
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewConverter(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	for httpStatusCode, grpcCode := range httpGRPCCodeMap {
+		var actual codes.Code = c.GRPCCode(httpStatusCode)
+
+		if grpcCode != actual {
+			t.Errorf("%d: expectation is %d, got %d", httpStatusCode, grpcCode, actual)
+		}
+	}
+
+	for grpcCode, httpStatusCode := range grpcHTTPCodeMap {
+		var actual int = c.HTTPStatusCode(grpcCode)
+
+		if httpStatusCode != actual {
+			t.Errorf("%s: expectation is %d, got %d", grpcCode, httpStatusCode, actual)
+		}
+	}
+}
+
+func TestConverterSetHTTPToGRPC(t *testing.T) {
+	var (
+		c     *Converter = NewConverter()
+		other *Converter = NewConverter()
+	)
+
+	c.SetHTTPToGRPC(http.StatusConflict, codes.Aborted)
+	c.SetHTTPToGRPC(http.StatusTeapot, codes.InvalidArgument)
+
+	var testCases []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "overridden",
+			Converter:      c,
+			HTTPStatusCode: http.StatusConflict,
+			Expectation:    codes.Aborted,
+		},
+		{
+			Name:           "added",
+			Converter:      c,
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           "other converter untouched",
+			Converter:      other,
+			HTTPStatusCode: http.StatusConflict,
+			Expectation:    codes.AlreadyExists,
+		},
+		{
+			Name:           "default converter untouched",
+			Converter:      defaultConverter,
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = testCases[i].Converter.GRPCCode(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+
+	var reverse int = c.HTTPStatusCode(codes.AlreadyExists)
+
+	if reverse != http.StatusConflict {
+		t.Errorf("expectation is %d, got %d", http.StatusConflict, reverse)
+	}
+}
+
+func TestConverterSetGRPCToHTTP(t *testing.T) {
+	var (
+		c     *Converter = NewConverter()
+		other *Converter = NewConverter()
+	)
+
+	c.SetGRPCToHTTP(codes.Canceled, 499)
+
+	var testCases []struct {
+		Name        string
+		Converter   *Converter
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		Converter   *Converter
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        "overridden",
+			Converter:   c,
+			GRPCCode:    codes.Canceled,
+			Expectation: 499,
+		},
+		{
+			Name:        "other converter untouched",
+			Converter:   other,
+			GRPCCode:    codes.Canceled,
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        "default converter untouched",
+			Converter:   defaultConverter,
+			GRPCCode:    codes.Canceled,
+			Expectation: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = testCases[i].Converter.HTTPStatusCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestConverterSetHTTPToGRPCOutOfTableRange(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	c.SetHTTPToGRPC(999, codes.Aborted)
+	c.SetHTTPToGRPC(-1, codes.InvalidArgument)
+
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "999",
+			HTTPStatusCode: 999,
+			Expectation:    codes.Aborted,
+		},
+		{
+			Name:           "-1",
+			HTTPStatusCode: -1,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           "1000",
+			HTTPStatusCode: 1000,
+			Expectation:    codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = c.GRPCCode(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func benchmarkLookupHTTPStatusCodes() []int {
+	var httpStatusCodes []int = make([]int, 0, 400)
+
+	for httpStatusCode := 200; httpStatusCode < 600; httpStatusCode++ {
+		httpStatusCodes = append(httpStatusCodes, httpStatusCode)
+	}
+
+	return httpStatusCodes
+}
+
+func BenchmarkConverterGRPCCodeOK(b *testing.B) {
+	var (
+		c               *Converter = NewConverter()
+		httpStatusCodes []int      = benchmarkLookupHTTPStatusCodes()
+	)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		c.GRPCCodeOK(httpStatusCodes[n%len(httpStatusCodes)])
+	}
+}
+
+func BenchmarkConverterGRPCCodeOKMap(b *testing.B) {
+	var (
+		httpToGRPC      map[int]codes.Code = NewConverter().state.Load().httpToGRPC
+		httpStatusCodes []int              = benchmarkLookupHTTPStatusCodes()
+	)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, _ = httpToGRPC[httpStatusCodes[n%len(httpStatusCodes)]]
+	}
+}
+
+func BenchmarkConverterGRPCCodeOKParallel(b *testing.B) {
+	var (
+		c               *Converter = NewConverter()
+		httpStatusCodes []int      = benchmarkLookupHTTPStatusCodes()
+		done            chan bool  = make(chan bool)
+		wg              sync.WaitGroup
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				c.SetBidirectional(http.StatusConflict, codes.Aborted)
+			}
+		}
+	}()
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		var n int
+
+		for pb.Next() {
+			c.GRPCCodeOK(httpStatusCodes[n%len(httpStatusCodes)])
+			n++
+		}
+	})
+
+	b.StopTimer()
+	close(done)
+	wg.Wait()
+}
+
+func TestConverterConcurrentOverrideAndLookup(t *testing.T) {
+	var (
+		c  *Converter = NewConverter()
+		wg sync.WaitGroup
+	)
+
+	c.SetBidirectional(http.StatusConflict, codes.Aborted)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for n := 0; n < 1000; n++ {
+				var actualGRPCCode codes.Code = c.GRPCCode(http.StatusConflict)
+
+				if actualGRPCCode != codes.Aborted && actualGRPCCode != codes.FailedPrecondition {
+					t.Errorf("expectation is %d or %d, got %d", codes.Aborted, codes.FailedPrecondition, actualGRPCCode)
+					return
+				}
+
+				c.HTTPStatusCode(codes.Aborted)
+				c.SuccessStatusForMethod("/pkg.Service/Create")
+				c.HTTPToGRPCMappings()
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for n := 0; n < 1000; n++ {
+			if n%2 == 0 {
+				c.SetBidirectional(http.StatusConflict, codes.Aborted)
+			} else {
+				c.SetBidirectional(http.StatusConflict, codes.FailedPrecondition)
+			}
+
+			c.SetSuccessStatusForMethod("/pkg.Service/Create", http.StatusCreated)
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestConverterRangeHTTPToGRPCOverridingInCallback(t *testing.T) {
+	var (
+		c        *Converter = NewConverter()
+		expected int        = len(c.HTTPToGRPCMappings())
+		actual   int
+	)
+
+	c.RangeHTTPToGRPC(func(httpStatusCode int, grpcCode codes.Code) bool {
+		c.SetHTTPToGRPC(httpStatusCode+1000, grpcCode)
+		actual++
+
+		return true
+	})
+
+	if expected != actual {
+		t.Errorf("expectation is %d, got %d", expected, actual)
+	}
+
+	if 2*expected != len(c.HTTPToGRPCMappings()) {
+		t.Errorf("expectation is %d, got %d", 2*expected, len(c.HTTPToGRPCMappings()))
+	}
+}
+
+func TestConverterGRPCCodeOKMatchesGRPCCode(t *testing.T) {
+	var c *Converter = NewConverter(WithKnownUnmapped(http.StatusTeapot))
+
+	var testCases []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	} = []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	}{
+		{
+			Name:                "mapped",
+			HTTPStatusCode:      http.StatusNotFound,
+			ExpectationGRPCCode: codes.NotFound,
+			ExpectationOK:       true,
+		},
+		{
+			Name:                "unmapped success",
+			HTTPStatusCode:      http.StatusNoContent,
+			ExpectationGRPCCode: codes.OK,
+			ExpectationOK:       false,
+		},
+		{
+			Name:                "known unmapped",
+			HTTPStatusCode:      http.StatusTeapot,
+			ExpectationGRPCCode: codes.InvalidArgument,
+			ExpectationOK:       false,
+		},
+		{
+			Name:                "unmapped",
+			HTTPStatusCode:      999,
+			ExpectationGRPCCode: codes.Unknown,
+			ExpectationOK:       false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualGRPCCode codes.Code
+				actualOK       bool
+			)
+
+			actualGRPCCode, actualOK = c.GRPCCodeOK(testCases[i].HTTPStatusCode)
+
+			if testCases[i].ExpectationGRPCCode != actualGRPCCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationGRPCCode, actualGRPCCode)
+			}
+
+			if testCases[i].ExpectationOK != actualOK {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationOK, actualOK)
+			}
+
+			if actualGRPCCode != c.GRPCCode(testCases[i].HTTPStatusCode) {
+				t.Errorf("expectation is %d, got %d", c.GRPCCode(testCases[i].HTTPStatusCode), actualGRPCCode)
+			}
+		})
+	}
+}
+
+func TestWithKnownUnmapped(t *testing.T) {
+	var (
+		c            *Converter = NewConverter(WithKnownUnmapped(http.StatusTeapot, http.StatusInsufficientStorage, http.StatusFound))
+		withFallback *Converter = NewConverter(WithKnownUnmapped(http.StatusTeapot, http.StatusInsufficientStorage), WithKnownUnmappedFallback(codes.FailedPrecondition))
+		without      *Converter = NewConverter()
+	)
+
+	var testCases []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		Converter      *Converter
+		HTTPStatusCode int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "known 4xx",
+			Converter:      c,
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           "known 5xx",
+			Converter:      c,
+			HTTPStatusCode: http.StatusInsufficientStorage,
+			Expectation:    codes.Internal,
+		},
+		{
+			Name:           "known 3xx",
+			Converter:      c,
+			HTTPStatusCode: http.StatusFound,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           "known 4xx with fallback",
+			Converter:      withFallback,
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.FailedPrecondition,
+		},
+		{
+			Name:           "known 5xx with fallback",
+			Converter:      withFallback,
+			HTTPStatusCode: http.StatusInsufficientStorage,
+			Expectation:    codes.FailedPrecondition,
+		},
+		{
+			Name:           "unknown with fallback",
+			Converter:      withFallback,
+			HTTPStatusCode: 999,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "unknown",
+			Converter:      c,
+			HTTPStatusCode: 999,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "unknown 4xx",
+			Converter:      c,
+			HTTPStatusCode: http.StatusUnavailableForLegalReasons,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "mapped",
+			Converter:      c,
+			HTTPStatusCode: http.StatusNotFound,
+			Expectation:    codes.NotFound,
+		},
+		{
+			Name:           "without option",
+			Converter:      without,
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = testCases[i].Converter.GRPCCode(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestConverterSetBidirectional(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	c.SetBidirectional(http.StatusConflict, codes.Aborted)
+
+	var (
+		actualGRPCCode       codes.Code = c.GRPCCode(http.StatusConflict)
+		actualHTTPStatusCode int        = c.HTTPStatusCode(codes.Aborted)
+	)
+
+	if codes.Aborted != actualGRPCCode {
+		t.Errorf("expectation is %d, got %d", codes.Aborted, actualGRPCCode)
+	}
+
+	if http.StatusConflict != actualHTTPStatusCode {
+		t.Errorf("expectation is %d, got %d", http.StatusConflict, actualHTTPStatusCode)
+	}
+}
+
+func TestConverterSetBidirectionalManyToOne(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	c.SetBidirectional(http.StatusConflict, codes.Aborted)
+	c.SetBidirectional(http.StatusPreconditionFailed, codes.Aborted)
+
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           http.StatusText(http.StatusConflict),
+			HTTPStatusCode: http.StatusConflict,
+			Expectation:    codes.Aborted,
+		},
+		{
+			Name:           http.StatusText(http.StatusPreconditionFailed),
+			HTTPStatusCode: http.StatusPreconditionFailed,
+			Expectation:    codes.Aborted,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = c.GRPCCode(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+
+	var actualHTTPStatusCode int = c.HTTPStatusCode(codes.Aborted)
+
+	if http.StatusPreconditionFailed != actualHTTPStatusCode {
+		t.Errorf("expectation is last write %d, got %d", http.StatusPreconditionFailed, actualHTTPStatusCode)
+	}
+}
+
+func TestConverterSetBidirectionalThenOneDirectional(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	c.SetBidirectional(http.StatusConflict, codes.Aborted)
+	c.SetGRPCToHTTP(codes.Aborted, http.StatusPreconditionFailed)
+
+	var (
+		actualGRPCCode       codes.Code = c.GRPCCode(http.StatusConflict)
+		actualHTTPStatusCode int        = c.HTTPStatusCode(codes.Aborted)
+	)
+
+	if codes.Aborted != actualGRPCCode {
+		t.Errorf("expectation is %d, got %d", codes.Aborted, actualGRPCCode)
+	}
+
+	if http.StatusPreconditionFailed != actualHTTPStatusCode {
+		t.Errorf("expectation is %d, got %d", http.StatusPreconditionFailed, actualHTTPStatusCode)
+	}
+}
@@ -0,0 +1,30 @@
+package gostacode
+
+const (
+	minHTTPStatusCode int = 100
+	maxHTTPStatusCode int = 599
+)
+
+// UnmappedHTTPStatusesInRange returns, in ascending order, every HTTP status code in [lo, hi]
+// that has no explicit gRPC mapping and therefore resolves through a fallback.
+// The range is clamped to the valid 100 to 599 range, see IsValidHTTPStatusCode.
+func UnmappedHTTPStatusesInRange(lo, hi int) []int {
+	var (
+		s        *converterState = defaultConverter.state.Load()
+		unmapped []int
+	)
+
+	lo = max(lo, minHTTPStatusCode)
+	hi = min(hi, maxHTTPStatusCode)
+
+	for httpStatusCode := lo; httpStatusCode <= hi; httpStatusCode++ {
+		var ok bool
+
+		_, ok = s.mappedGRPCCode(httpStatusCode)
+		if !ok {
+			unmapped = append(unmapped, httpStatusCode)
+		}
+	}
+
+	return unmapped
+}
@@ -0,0 +1,126 @@
+package gostacode
+
+import (
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestUnmappedHTTPStatusesInRange(t *testing.T) {
+	var (
+		actual   []int
+		unmapped map[int]bool = map[int]bool{}
+	)
+
+	actual = UnmappedHTTPStatusesInRange(400, 431)
+
+	for i := range actual {
+		if i > 0 && actual[i-1] >= actual[i] {
+			t.Errorf("expectation is ascending order, got %d before %d", actual[i-1], actual[i])
+		}
+
+		unmapped[actual[i]] = true
+	}
+
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	}{
+		{
+			Name:           http.StatusText(http.StatusPaymentRequired),
+			HTTPStatusCode: http.StatusPaymentRequired,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusGone),
+			HTTPStatusCode: http.StatusGone,
+			Expectation:    false,
+		},
+		{
+			Name:           http.StatusText(http.StatusTeapot),
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusBadRequest),
+			HTTPStatusCode: http.StatusBadRequest,
+			Expectation:    false,
+		},
+		{
+			Name:           http.StatusText(http.StatusNotFound),
+			HTTPStatusCode: http.StatusNotFound,
+			Expectation:    false,
+		},
+		{
+			Name:           http.StatusText(http.StatusTooManyRequests),
+			HTTPStatusCode: http.StatusTooManyRequests,
+			Expectation:    false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if testCases[i].Expectation != unmapped[testCases[i].HTTPStatusCode] {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, unmapped[testCases[i].HTTPStatusCode])
+			}
+		})
+	}
+}
+
+func TestUnmappedHTTPStatusesInRangeClamped(t *testing.T) {
+	var testCases []struct {
+		Name            string
+		Lo              int
+		Hi              int
+		ExpectationLen  int
+		ExpectationHead int
+		ExpectationTail int
+	} = []struct {
+		Name            string
+		Lo              int
+		Hi              int
+		ExpectationLen  int
+		ExpectationHead int
+		ExpectationTail int
+	}{
+		{
+			Name:            "whole int range",
+			Lo:              math.MinInt,
+			Hi:              math.MaxInt,
+			ExpectationLen:  len(UnmappedHTTPStatusesInRange(100, 599)),
+			ExpectationHead: 100,
+			ExpectationTail: 599,
+		},
+		{
+			Name:            "above 599",
+			Lo:              600,
+			Hi:              math.MaxInt,
+			ExpectationLen:  0,
+			ExpectationHead: 0,
+			ExpectationTail: 0,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []int = UnmappedHTTPStatusesInRange(testCases[i].Lo, testCases[i].Hi)
+
+			if testCases[i].ExpectationLen != len(actual) {
+				t.Fatalf("expectation length is %d, got %d", testCases[i].ExpectationLen, len(actual))
+			}
+
+			if len(actual) == 0 {
+				return
+			}
+
+			if testCases[i].ExpectationHead != actual[0] || testCases[i].ExpectationTail != actual[len(actual)-1] {
+				t.Errorf("expectation is [%d, %d], got [%d, %d]", testCases[i].ExpectationHead, testCases[i].ExpectationTail, actual[0], actual[len(actual)-1])
+			}
+		})
+	}
+}
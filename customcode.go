@@ -0,0 +1,67 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// WithCustomCodeScheme registers scheme as the bidirectional mapping between gRPC codes
+// and a custom integer error code scheme, replacing any previously registered scheme.
+// When several gRPC codes share a custom code, the lowest gRPC code wins the reverse direction.
+func WithCustomCodeScheme(scheme map[codes.Code]int) Option {
+	return func(s *converterState) {
+		s.grpcToCustomCode = make(map[codes.Code]int, len(scheme))
+		s.customCodeToGRPC = make(map[int]codes.Code, len(scheme))
+
+		for grpcCode, customCode := range scheme {
+			s.grpcToCustomCode[grpcCode] = customCode
+
+			var (
+				existingGRPCCode codes.Code
+				ok               bool
+			)
+
+			existingGRPCCode, ok = s.customCodeToGRPC[customCode]
+			if !ok || grpcCode < existingGRPCCode {
+				s.customCodeToGRPC[customCode] = grpcCode
+			}
+		}
+	}
+}
+
+// CustomCode returns the custom code mapped from grpcCode by the scheme registered with WithCustomCodeScheme
+// and whether the scheme has an entry for it.
+func (c *Converter) CustomCode(grpcCode codes.Code) (int, bool) {
+	var (
+		customCode int
+		ok         bool
+	)
+
+	customCode, ok = c.state.Load().grpcToCustomCode[grpcCode]
+
+	return customCode, ok
+}
+
+// GRPCCodeFromCustomCode returns the gRPC code mapped from customCode by the scheme registered with WithCustomCodeScheme
+// and whether the scheme has an entry for it.
+func (c *Converter) GRPCCodeFromCustomCode(customCode int) (codes.Code, bool) {
+	var (
+		grpcCode codes.Code
+		ok       bool
+	)
+
+	grpcCode, ok = c.state.Load().customCodeToGRPC[customCode]
+
+	return grpcCode, ok
+}
+
+// CustomCodeFromGRPC is (*Converter).CustomCode on the default converter.
+// Register a scheme on it with Configure and WithCustomCodeScheme.
+func CustomCodeFromGRPC(grpcCode codes.Code) (int, bool) {
+	return defaultConverter.CustomCode(grpcCode)
+}
+
+// GRPCFromCustomCode is (*Converter).GRPCCodeFromCustomCode on the default converter.
+// Register a scheme on it with Configure and WithCustomCodeScheme.
+func GRPCFromCustomCode(custom int) (codes.Code, bool) {
+	return defaultConverter.GRPCCodeFromCustomCode(custom)
+}
@@ -0,0 +1,121 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCustomCodeScheme(t *testing.T) {
+	var c *Converter = NewConverter(WithCustomCodeScheme(map[codes.Code]int{
+		codes.NotFound:        1004,
+		codes.InvalidArgument: 1001,
+		codes.Internal:        1500,
+		codes.DataLoss:        1500,
+	}))
+
+	var testCases []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		ExpectationCustomCode int
+		ExpectationGRPCCode   codes.Code
+		ExpectationOK         bool
+	} = []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		ExpectationCustomCode int
+		ExpectationGRPCCode   codes.Code
+		ExpectationOK         bool
+	}{
+		{
+			Name:                  codes.NotFound.String(),
+			GRPCCode:              codes.NotFound,
+			ExpectationCustomCode: 1004,
+			ExpectationGRPCCode:   codes.NotFound,
+			ExpectationOK:         true,
+		},
+		{
+			Name:                  codes.InvalidArgument.String(),
+			GRPCCode:              codes.InvalidArgument,
+			ExpectationCustomCode: 1001,
+			ExpectationGRPCCode:   codes.InvalidArgument,
+			ExpectationOK:         true,
+		},
+		{
+			Name:                  codes.DataLoss.String(),
+			GRPCCode:              codes.DataLoss,
+			ExpectationCustomCode: 1500,
+			ExpectationGRPCCode:   codes.Internal,
+			ExpectationOK:         true,
+		},
+		{
+			Name:                  codes.Unavailable.String(),
+			GRPCCode:              codes.Unavailable,
+			ExpectationCustomCode: 0,
+			ExpectationGRPCCode:   codes.OK,
+			ExpectationOK:         false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCustomCode int
+				actualGRPCCode   codes.Code
+				actualOK         bool
+			)
+
+			actualCustomCode, actualOK = c.CustomCode(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationCustomCode != actualCustomCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationCustomCode, actualCustomCode)
+			}
+
+			if testCases[i].ExpectationOK != actualOK {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationOK, actualOK)
+			}
+
+			if !actualOK {
+				return
+			}
+
+			actualGRPCCode, actualOK = c.GRPCCodeFromCustomCode(actualCustomCode)
+
+			if testCases[i].ExpectationGRPCCode != actualGRPCCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationGRPCCode, actualGRPCCode)
+			}
+
+			if !actualOK {
+				t.Errorf("expectation is %t, got %t", true, actualOK)
+			}
+		})
+	}
+}
+
+func TestCustomCodeSchemeDefaultConverter(t *testing.T) {
+	var previous *converterState = defaultConverter.state.Load()
+
+	defer defaultConverter.state.Store(previous)
+
+	Configure(WithCustomCodeScheme(map[codes.Code]int{
+		codes.NotFound: 1004,
+	}))
+
+	var (
+		actualCustomCode int
+		actualGRPCCode   codes.Code
+		actualOK         bool
+	)
+
+	actualCustomCode, actualOK = CustomCodeFromGRPC(codes.NotFound)
+
+	if 1004 != actualCustomCode || !actualOK {
+		t.Errorf("expectation is %d and %t, got %d and %t", 1004, true, actualCustomCode, actualOK)
+	}
+
+	actualGRPCCode, actualOK = GRPCFromCustomCode(1004)
+
+	if codes.NotFound != actualGRPCCode || !actualOK {
+		t.Errorf("expectation is %d and %t, got %d and %t", codes.NotFound, true, actualGRPCCode, actualOK)
+	}
+}
@@ -0,0 +1,13 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// WithDataLossAs maps DataLoss to httpStatusCode instead of 500 Internal Server Error,
+// such as 507 Insufficient Storage for storage services whose clients must tell data loss apart from generic failures.
+func WithDataLossAs(httpStatusCode int) Option {
+	return func(s *converterState) {
+		s.grpcToHTTP[codes.DataLoss] = httpStatusCode
+	}
+}
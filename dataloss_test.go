@@ -0,0 +1,41 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestWithDataLossAs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Converter   *Converter
+		Expectation int
+	} = []struct {
+		Name        string
+		Converter   *Converter
+		Expectation int
+	}{
+		{
+			Name:        "default",
+			Converter:   NewConverter(),
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        http.StatusText(http.StatusInsufficientStorage),
+			Converter:   NewConverter(WithDataLossAs(http.StatusInsufficientStorage)),
+			Expectation: http.StatusInsufficientStorage,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = testCases[i].Converter.HTTPStatusCode(codes.DataLoss)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
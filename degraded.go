@@ -0,0 +1,33 @@
+package gostacode
+
+import (
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodeFromHTTPStatusAndLatency maps httpStatusCode like GRPCCodeFromHTTPStatusCode,
+// except that a successful response that took longer than threshold maps to DeadlineExceeded.
+func GRPCCodeFromHTTPStatusAndLatency(httpStatusCode int, latency, threshold time.Duration) codes.Code {
+	var grpcCode codes.Code = GRPCCodeFromHTTPStatusCode(httpStatusCode)
+
+	if grpcCode == codes.OK && latency > threshold {
+		return codes.DeadlineExceeded
+	}
+
+	return grpcCode
+}
+
+// HTTPStatusFromGRPCCodeMaintenance maps grpcCode like HTTPStatusCodeFromGRPCCode during a maintenance window:
+// every code that maps to a 5xx status becomes 503 Service Unavailable so clients back off,
+// while OK and client errors keep their usual status.
+func HTTPStatusFromGRPCCodeMaintenance(grpcCode codes.Code) int {
+	var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCode)
+
+	if httpStatusCode >= 500 && httpStatusCode <= 599 {
+		return http.StatusServiceUnavailable
+	}
+
+	return httpStatusCode
+}
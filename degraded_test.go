@@ -0,0 +1,120 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodeFromHTTPStatusAndLatency(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Latency        time.Duration
+		Threshold      time.Duration
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Latency        time.Duration
+		Threshold      time.Duration
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "fast success",
+			HTTPStatusCode: http.StatusOK,
+			Latency:        100 * time.Millisecond,
+			Threshold:      time.Second,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           "slow success",
+			HTTPStatusCode: http.StatusOK,
+			Latency:        2 * time.Second,
+			Threshold:      time.Second,
+			Expectation:    codes.DeadlineExceeded,
+		},
+		{
+			Name:           "slow failure",
+			HTTPStatusCode: http.StatusNotFound,
+			Latency:        2 * time.Second,
+			Threshold:      time.Second,
+			Expectation:    codes.NotFound,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPStatusAndLatency(testCases[i].HTTPStatusCode, testCases[i].Latency, testCases[i].Threshold)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusFromGRPCCodeMaintenance(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.Unimplemented.String(),
+			GRPCCode:    codes.Unimplemented,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.Canceled.String(),
+			GRPCCode:    codes.Canceled,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: http.StatusNotFound,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: http.StatusBadRequest,
+		},
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusFromGRPCCodeMaintenance(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WithDeprecatedCodes marks the gRPC codes in sunsets as deprecated, each with its sunset date.
+// The date is reported verbatim by HTTPStatusWithDeprecation, so use the format the Sunset header expects.
+func WithDeprecatedCodes(sunsets map[codes.Code]string) Option {
+	return func(s *converterState) {
+		for grpcCode, sunset := range sunsets {
+			s.deprecated[grpcCode] = sunset
+		}
+	}
+}
+
+// HTTPStatusWithDeprecation returns the HTTP status code mapped from grpcCode,
+// whether grpcCode is deprecated, and its sunset date when it is.
+func (c *Converter) HTTPStatusWithDeprecation(grpcCode codes.Code) (int, bool, string) {
+	var (
+		s      *converterState = c.state.Load()
+		sunset string
+		ok     bool
+	)
+
+	sunset, ok = s.deprecated[grpcCode]
+
+	return LookupOrDefault(s.grpcToHTTP, grpcCode, http.StatusInternalServerError), ok, sunset
+}
+
+// HTTPStatusWithDeprecation is (*Converter).HTTPStatusWithDeprecation on the default converter.
+// Register deprecated codes on it with Configure and WithDeprecatedCodes.
+func HTTPStatusWithDeprecation(grpcCode codes.Code) (int, bool, string) {
+	return defaultConverter.HTTPStatusWithDeprecation(grpcCode)
+}
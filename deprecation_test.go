@@ -0,0 +1,109 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatusWithDeprecation(t *testing.T) {
+	var c *Converter = NewConverter(WithDeprecatedCodes(map[codes.Code]string{
+		codes.OutOfRange: "Wed, 11 Nov 2026 23:59:59 GMT",
+	}))
+
+	var testCases []struct {
+		Name                  string
+		Converter             *Converter
+		GRPCCode              codes.Code
+		ExpectationStatus     int
+		ExpectationDeprecated bool
+		ExpectationSunset     string
+	} = []struct {
+		Name                  string
+		Converter             *Converter
+		GRPCCode              codes.Code
+		ExpectationStatus     int
+		ExpectationDeprecated bool
+		ExpectationSunset     string
+	}{
+		{
+			Name:                  "deprecated code",
+			Converter:             c,
+			GRPCCode:              codes.OutOfRange,
+			ExpectationStatus:     http.StatusBadRequest,
+			ExpectationDeprecated: true,
+			ExpectationSunset:     "Wed, 11 Nov 2026 23:59:59 GMT",
+		},
+		{
+			Name:                  "normal code",
+			Converter:             c,
+			GRPCCode:              codes.NotFound,
+			ExpectationStatus:     http.StatusNotFound,
+			ExpectationDeprecated: false,
+			ExpectationSunset:     "",
+		},
+		{
+			Name:                  "default converter",
+			Converter:             defaultConverter,
+			GRPCCode:              codes.OutOfRange,
+			ExpectationStatus:     http.StatusBadRequest,
+			ExpectationDeprecated: false,
+			ExpectationSunset:     "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatus     int
+				actualDeprecated bool
+				actualSunset     string
+			)
+
+			actualStatus, actualDeprecated, actualSunset = testCases[i].Converter.HTTPStatusWithDeprecation(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationStatus != actualStatus {
+				t.Errorf("expectation status is %d, got %d", testCases[i].ExpectationStatus, actualStatus)
+			}
+
+			if testCases[i].ExpectationDeprecated != actualDeprecated {
+				t.Errorf("expectation deprecated is %t, got %t", testCases[i].ExpectationDeprecated, actualDeprecated)
+			}
+
+			if testCases[i].ExpectationSunset != actualSunset {
+				t.Errorf("expectation sunset is %q, got %q", testCases[i].ExpectationSunset, actualSunset)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusWithDeprecationDefaultConverter(t *testing.T) {
+	var previous *converterState = defaultConverter.state.Load()
+
+	defer defaultConverter.state.Store(previous)
+
+	Configure(WithDeprecatedCodes(map[codes.Code]string{
+		codes.OutOfRange: "Wed, 11 Nov 2026 23:59:59 GMT",
+	}))
+
+	var (
+		actualStatus     int
+		actualDeprecated bool
+		actualSunset     string
+	)
+
+	actualStatus, actualDeprecated, actualSunset = HTTPStatusWithDeprecation(codes.OutOfRange)
+
+	if http.StatusBadRequest != actualStatus {
+		t.Errorf("expectation status is %d, got %d", http.StatusBadRequest, actualStatus)
+	}
+
+	if !actualDeprecated {
+		t.Errorf("expectation deprecated is %t, got %t", true, actualDeprecated)
+	}
+
+	if "Wed, 11 Nov 2026 23:59:59 GMT" != actualSunset {
+		t.Errorf("expectation sunset is %q, got %q", "Wed, 11 Nov 2026 23:59:59 GMT", actualSunset)
+	}
+}
@@ -0,0 +1,55 @@
+package gostacode
+
+import (
+	"net/http"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+)
+
+// MappingDiff describes a gRPC code that the default converter and the canonical grpc-gateway mappings
+// convert to different HTTP status codes.
+type MappingDiff struct {
+	GRPCCode                codes.Code
+	DefaultHTTPStatusCode   int
+	CanonicalHTTPStatusCode int
+}
+
+// DefaultVsCanonicalDiff returns the deviations of the package-level functions from the canonical mappings
+// of grpc-gateway's runtime.HTTPStatusFromCode, served by the "grpc-gateway" profile,
+// such as Canceled mapping to 500 Internal Server Error instead of 499 Client Closed Request.
+// The diffs are ordered by gRPC code and include overrides configured on the default converter.
+func DefaultVsCanonicalDiff() []MappingDiff {
+	var (
+		defaultState   *converterState     = defaultConverter.state.Load()
+		canonicalState *converterState     = gatewayConverter.state.Load()
+		grpcCodes      map[codes.Code]bool = make(map[codes.Code]bool, len(defaultState.grpcToHTTP))
+		diffs          []MappingDiff
+	)
+
+	for grpcCode := range defaultState.grpcToHTTP {
+		grpcCodes[grpcCode] = true
+	}
+
+	for grpcCode := range canonicalState.grpcToHTTP {
+		grpcCodes[grpcCode] = true
+	}
+
+	for grpcCode := range grpcCodes {
+		var diff MappingDiff = MappingDiff{
+			GRPCCode:                grpcCode,
+			DefaultHTTPStatusCode:   LookupOrDefault(defaultState.grpcToHTTP, grpcCode, http.StatusInternalServerError),
+			CanonicalHTTPStatusCode: LookupOrDefault(canonicalState.grpcToHTTP, grpcCode, http.StatusInternalServerError),
+		}
+
+		if diff.DefaultHTTPStatusCode != diff.CanonicalHTTPStatusCode {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].GRPCCode < diffs[j].GRPCCode
+	})
+
+	return diffs
+}
@@ -0,0 +1,40 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestDefaultVsCanonicalDiff(t *testing.T) {
+	var (
+		expectation MappingDiff = MappingDiff{
+			GRPCCode:                codes.Canceled,
+			DefaultHTTPStatusCode:   http.StatusInternalServerError,
+			CanonicalHTTPStatusCode: StatusClientClosedRequest,
+		}
+		actual []MappingDiff = DefaultVsCanonicalDiff()
+	)
+
+	if len(actual) != 1 {
+		t.Fatalf("expectation is 1 diff, got %v", actual)
+	}
+
+	if expectation != actual[0] {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual[0])
+	}
+}
+
+func TestDefaultVsCanonicalDiffWithOverride(t *testing.T) {
+	var previous *converterState = defaultConverter.state.Load()
+	defer defaultConverter.state.Store(previous)
+
+	Configure(WithClientClosedRequest())
+
+	var actual []MappingDiff = DefaultVsCanonicalDiff()
+
+	if len(actual) != 0 {
+		t.Errorf("expectation is no diff, got %v", actual)
+	}
+}
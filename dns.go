@@ -0,0 +1,42 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// DNS RCODE values as defined by RFC 1035 section 4.1.1 and registered by RFC 6895.
+const (
+	dnsRCodeNoError  int = 0
+	dnsRCodeServFail int = 2
+	dnsRCodeNXDomain int = 3
+	dnsRCodeNotImp   int = 4
+	dnsRCodeRefused  int = 5
+)
+
+var (
+	grpcDNSRCodeMap map[codes.Code]int = map[codes.Code]int{
+		codes.OK:                dnsRCodeNoError,
+		codes.NotFound:          dnsRCodeNXDomain,
+		codes.PermissionDenied:  dnsRCodeRefused,
+		codes.ResourceExhausted: dnsRCodeRefused,
+		codes.Unimplemented:     dnsRCodeNotImp,
+		codes.Unknown:           dnsRCodeServFail,
+		codes.Internal:          dnsRCodeServFail,
+	}
+)
+
+// DNSRCodeFromGRPCCode returns the DNS response code (RFC 1035, RFC 6895) for grpcCode.
+// Codes without a DNS counterpart map to SERVFAIL (2).
+func DNSRCodeFromGRPCCode(grpcCode codes.Code) int {
+	var (
+		rcode int
+		ok    bool
+	)
+
+	rcode, ok = grpcDNSRCodeMap[grpcCode]
+	if !ok {
+		return dnsRCodeServFail
+	}
+
+	return rcode
+}
@@ -0,0 +1,70 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestDNSRCodeFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: 0,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: 3,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: 5,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: 5,
+		},
+		{
+			Name:        codes.Unimplemented.String(),
+			GRPCCode:    codes.Unimplemented,
+			Expectation: 4,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: 2,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: 2,
+		},
+		{
+			Name:        codes.Aborted.String(),
+			GRPCCode:    codes.Aborted,
+			Expectation: 2,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = DNSRCodeFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
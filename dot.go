@@ -0,0 +1,91 @@
+package gostacode
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WriteDOT writes the mapping tables to w as a Graphviz DOT digraph,
+// with one node per HTTP status code and gRPC code and one edge per mapping in each direction.
+// Nodes and edges are written in ascending code order so the output is stable.
+func (c *Converter) WriteDOT(w io.Writer) error {
+	var (
+		s               *converterState    = c.state.Load()
+		httpToGRPC      map[int]codes.Code = s.httpToGRPC
+		grpcToHTTP      map[codes.Code]int = s.grpcToHTTP
+		httpStatusCodes []int
+		grpcCodes       []codes.Code
+		seenGRPCCodes   map[codes.Code]bool = map[codes.Code]bool{}
+		seenHTTPCodes   map[int]bool        = map[int]bool{}
+		sb              strings.Builder
+		err             error
+	)
+
+	for httpStatusCode, grpcCode := range httpToGRPC {
+		seenHTTPCodes[httpStatusCode] = true
+		seenGRPCCodes[grpcCode] = true
+	}
+
+	for grpcCode, httpStatusCode := range grpcToHTTP {
+		seenHTTPCodes[httpStatusCode] = true
+		seenGRPCCodes[grpcCode] = true
+	}
+
+	for httpStatusCode := range seenHTTPCodes {
+		httpStatusCodes = append(httpStatusCodes, httpStatusCode)
+	}
+
+	for grpcCode := range seenGRPCCodes {
+		grpcCodes = append(grpcCodes, grpcCode)
+	}
+
+	sort.Ints(httpStatusCodes)
+	sort.Slice(grpcCodes, func(i, j int) bool {
+		return grpcCodes[i] < grpcCodes[j]
+	})
+
+	sb.WriteString("digraph gostacode {\n")
+	sb.WriteString("\trankdir=LR;\n")
+
+	for i := range httpStatusCodes {
+		fmt.Fprintf(&sb, "\thttp_%d [label=\"%d\", shape=box];\n", httpStatusCodes[i], httpStatusCodes[i])
+	}
+
+	for i := range grpcCodes {
+		fmt.Fprintf(&sb, "\tgrpc_%d [label=%q, shape=ellipse];\n", grpcCodes[i], grpcCodes[i].String())
+	}
+
+	for i := range httpStatusCodes {
+		var (
+			grpcCode codes.Code
+			ok       bool
+		)
+
+		grpcCode, ok = httpToGRPC[httpStatusCodes[i]]
+		if ok {
+			fmt.Fprintf(&sb, "\thttp_%d -> grpc_%d;\n", httpStatusCodes[i], grpcCode)
+		}
+	}
+
+	for i := range grpcCodes {
+		var (
+			httpStatusCode int
+			ok             bool
+		)
+
+		httpStatusCode, ok = grpcToHTTP[grpcCodes[i]]
+		if ok {
+			fmt.Fprintf(&sb, "\tgrpc_%d -> http_%d;\n", grpcCodes[i], httpStatusCode)
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	_, err = io.WriteString(w, sb.String())
+
+	return err
+}
@@ -0,0 +1,35 @@
+package gostacode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConverterWriteDOT(t *testing.T) {
+	var (
+		buf bytes.Buffer
+		err error
+	)
+
+	err = NewConverter().WriteDOT(&buf)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %v", err)
+	}
+
+	var testCases []string = []string{
+		"digraph",
+		`label="404"`,
+		`label="NotFound"`,
+		"http_404 -> grpc_5;",
+		"grpc_5 -> http_404;",
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i], func(t *testing.T) {
+			if !strings.Contains(buf.String(), testCases[i]) {
+				t.Errorf("expectation output contains %q, got %q", testCases[i], buf.String())
+			}
+		})
+	}
+}
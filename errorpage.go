@@ -0,0 +1,46 @@
+package gostacode
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WithErrorPageTemplateForGRPCCode overrides the template ErrorPage returns for grpcCode.
+func WithErrorPageTemplateForGRPCCode(grpcCode codes.Code, template string) Option {
+	return func(s *converterState) {
+		s.errorPageTemplates[grpcCode] = template
+	}
+}
+
+// ErrorPage returns the HTTP status code mapped from grpcCode and the name of the error page template to render.
+// Client errors use "<status>.html", such as "404.html", every server error uses "500.html",
+// and codes that do not map to an error status have no template, unless overridden with WithErrorPageTemplateForGRPCCode.
+func (c *Converter) ErrorPage(grpcCode codes.Code) (int, string) {
+	var (
+		s              *converterState = c.state.Load()
+		httpStatusCode int             = LookupOrDefault(s.grpcToHTTP, grpcCode, http.StatusInternalServerError)
+		template       string
+		ok             bool
+	)
+
+	template, ok = s.errorPageTemplates[grpcCode]
+	if ok {
+		return httpStatusCode, template
+	}
+
+	switch {
+	case httpStatusCode >= http.StatusInternalServerError:
+		return httpStatusCode, "500.html"
+	case httpStatusCode >= http.StatusBadRequest:
+		return httpStatusCode, strconv.Itoa(httpStatusCode) + ".html"
+	default:
+		return httpStatusCode, ""
+	}
+}
+
+// ErrorPageForGRPCCode is (*Converter).ErrorPage on the default converter.
+func ErrorPageForGRPCCode(grpcCode codes.Code) (int, string) {
+	return defaultConverter.ErrorPage(grpcCode)
+}
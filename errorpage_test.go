@@ -0,0 +1,91 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorPageForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationTemplate       string
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationTemplate       string
+	}{
+		{
+			Name:                      codes.NotFound.String(),
+			GRPCCode:                  codes.NotFound,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationTemplate:       "404.html",
+		},
+		{
+			Name:                      codes.PermissionDenied.String(),
+			GRPCCode:                  codes.PermissionDenied,
+			ExpectationHTTPStatusCode: http.StatusForbidden,
+			ExpectationTemplate:       "403.html",
+		},
+		{
+			Name:                      codes.Internal.String(),
+			GRPCCode:                  codes.Internal,
+			ExpectationHTTPStatusCode: http.StatusInternalServerError,
+			ExpectationTemplate:       "500.html",
+		},
+		{
+			Name:                      codes.Unavailable.String(),
+			GRPCCode:                  codes.Unavailable,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationTemplate:       "500.html",
+		},
+		{
+			Name:                      codes.OK.String(),
+			GRPCCode:                  codes.OK,
+			ExpectationHTTPStatusCode: http.StatusOK,
+			ExpectationTemplate:       "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualTemplate       string
+			)
+
+			actualHTTPStatusCode, actualTemplate = ErrorPageForGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationHTTPStatusCode != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actualHTTPStatusCode)
+			}
+
+			if testCases[i].ExpectationTemplate != actualTemplate {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationTemplate, actualTemplate)
+			}
+		})
+	}
+}
+
+func TestWithErrorPageTemplateForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithErrorPageTemplateForGRPCCode(codes.Unavailable, "maintenance.html"))
+		actual string
+	)
+
+	_, actual = c.ErrorPage(codes.Unavailable)
+
+	if actual != "maintenance.html" {
+		t.Errorf("expectation is %q, got %q", "maintenance.html", actual)
+	}
+
+	_, actual = ErrorPageForGRPCCode(codes.Unavailable)
+
+	if actual != "500.html" {
+		t.Errorf("expectation is %q, got %q", "500.html", actual)
+	}
+}
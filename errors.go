@@ -0,0 +1,16 @@
+package gostacode
+
+import (
+	"errors"
+)
+
+var (
+	ErrHTTPStatusCodeNotAllowed error = errors.New("gostacode: http status code not allowed")
+	ErrUnmappedCode             error = errors.New("gostacode: unmapped code")
+	ErrInvalidRetryAfter        error = errors.New("gostacode: invalid retry-after")
+	ErrUnknownLegacyVersion     error = errors.New("gostacode: unknown legacy version")
+	ErrUnknownProfile           error = errors.New("gostacode: unknown profile")
+	ErrInvalidSuccessStatus     error = errors.New("gostacode: invalid success status")
+	ErrInvalidHTTPStatusCode    error = errors.New("gostacode: invalid http status code")
+	ErrNotInvertible            error = errors.New("gostacode: mapping not invertible")
+)
@@ -0,0 +1,61 @@
+package gostacode
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// PrimaryGRPCCodeForHTTPStatusExplained returns the gRPC code chosen for httpStatusCode
+// and why it was preferred over the other gRPC codes that map back to the same HTTP status.
+func PrimaryGRPCCodeForHTTPStatusExplained(httpStatusCode int) (codes.Code, string) {
+	var (
+		grpcCode     codes.Code
+		ok           bool
+		alternatives []codes.Code
+		names        []string
+	)
+
+	grpcCode, ok = GRPCCodeFromHTTPStatusCodeOK(httpStatusCode)
+	if !ok {
+		return grpcCode, fmt.Sprintf("%s used as fallback for unmapped %d", grpcCode, httpStatusCode)
+	}
+
+	RangeGRPCToHTTP(func(alternative codes.Code, mappedHTTPStatusCode int) bool {
+		if mappedHTTPStatusCode == httpStatusCode && alternative != grpcCode {
+			alternatives = append(alternatives, alternative)
+		}
+
+		return true
+	})
+
+	if len(alternatives) == 0 {
+		return grpcCode, fmt.Sprintf("%s has no alternative for %d", grpcCode, httpStatusCode)
+	}
+
+	sort.Slice(alternatives, func(i, j int) bool {
+		return alternatives[i] < alternatives[j]
+	})
+
+	for i := range alternatives {
+		names = append(names, alternatives[i].String())
+	}
+
+	return grpcCode, fmt.Sprintf("%s preferred over %s for %d by explicit mapping", grpcCode, strings.Join(names, ", "), httpStatusCode)
+}
+
+// HTTPConversionIsLossy reports whether another canonical gRPC code maps to the same HTTP status code as grpcCode,
+// in which case the original gRPC code cannot be recovered from the HTTP status code alone.
+func HTTPConversionIsLossy(grpcCode codes.Code) bool {
+	var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCode)
+
+	for other := codes.OK; other <= codes.Unauthenticated; other++ {
+		if other != grpcCode && HTTPStatusCodeFromGRPCCode(other) == httpStatusCode {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestPrimaryGRPCCodeForHTTPStatusExplained(t *testing.T) {
+	var testCases []struct {
+		Name                   string
+		HTTPStatusCode         int
+		ExpectationGRPCCode    codes.Code
+		ExpectationExplanation string
+	} = []struct {
+		Name                   string
+		HTTPStatusCode         int
+		ExpectationGRPCCode    codes.Code
+		ExpectationExplanation string
+	}{
+		{
+			Name:                   http.StatusText(http.StatusBadRequest),
+			HTTPStatusCode:         http.StatusBadRequest,
+			ExpectationGRPCCode:    codes.InvalidArgument,
+			ExpectationExplanation: "InvalidArgument preferred over FailedPrecondition, OutOfRange for 400 by explicit mapping",
+		},
+		{
+			Name:                   http.StatusText(http.StatusNotFound),
+			HTTPStatusCode:         http.StatusNotFound,
+			ExpectationGRPCCode:    codes.NotFound,
+			ExpectationExplanation: "NotFound has no alternative for 404",
+		},
+		{
+			Name:                   http.StatusText(http.StatusTeapot),
+			HTTPStatusCode:         http.StatusTeapot,
+			ExpectationGRPCCode:    codes.Unknown,
+			ExpectationExplanation: "Unknown used as fallback for unmapped 418",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualGRPCCode    codes.Code
+				actualExplanation string
+			)
+
+			actualGRPCCode, actualExplanation = PrimaryGRPCCodeForHTTPStatusExplained(testCases[i].HTTPStatusCode)
+
+			if testCases[i].ExpectationGRPCCode != actualGRPCCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationGRPCCode, actualGRPCCode)
+			}
+
+			if testCases[i].ExpectationExplanation != actualExplanation {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationExplanation, actualExplanation)
+			}
+		})
+	}
+}
+
+func TestHTTPConversionIsLossy(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Canceled.String(),
+			GRPCCode:    codes.Canceled,
+			Expectation: true,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: false,
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = HTTPConversionIsLossy(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
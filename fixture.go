@@ -0,0 +1,56 @@
+package gostacode
+
+import (
+	"net/http"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// unmappedFixtureHTTPStatusCodes are representative status codes without an explicit mapping,
+	// covering the 2xx class fallback and the plain fallback.
+	unmappedFixtureHTTPStatusCodes []int = []int{
+		http.StatusNoContent,
+		http.StatusFound,
+		http.StatusTeapot,
+		http.StatusHTTPVersionNotSupported,
+	}
+)
+
+// Fixture is the expected conversion of one HTTP status code, for contract tests of downstream integrations.
+type Fixture struct {
+	HTTPStatusCode          int
+	GRPCCode                codes.Code
+	RoundTripHTTPStatusCode int
+}
+
+// ExportFixtures returns a Fixture for every mapped HTTP status code and for a few representative unmapped ones,
+// ordered by HTTP status code. The expected values come from the package-level functions.
+func ExportFixtures() []Fixture {
+	var (
+		httpStatusCodes []int = make([]int, 0, len(httpGRPCCodeMap)+len(unmappedFixtureHTTPStatusCodes))
+		fixtures        []Fixture
+	)
+
+	RangeHTTPToGRPC(func(httpStatusCode int, grpcCode codes.Code) bool {
+		httpStatusCodes = append(httpStatusCodes, httpStatusCode)
+		return true
+	})
+
+	httpStatusCodes = append(httpStatusCodes, unmappedFixtureHTTPStatusCodes...)
+
+	sort.Ints(httpStatusCodes)
+
+	fixtures = make([]Fixture, len(httpStatusCodes))
+
+	for i := range httpStatusCodes {
+		fixtures[i] = Fixture{
+			HTTPStatusCode:          httpStatusCodes[i],
+			GRPCCode:                GRPCCodeFromHTTPStatusCode(httpStatusCodes[i]),
+			RoundTripHTTPStatusCode: RoundTripHTTP(httpStatusCodes[i]),
+		}
+	}
+
+	return fixtures
+}
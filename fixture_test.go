@@ -0,0 +1,40 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestExportFixtures(t *testing.T) {
+	var actual []Fixture = ExportFixtures()
+
+	if len(httpGRPCCodeMap)+len(unmappedFixtureHTTPStatusCodes) != len(actual) {
+		t.Fatalf("expectation is %d fixtures, got %d", len(httpGRPCCodeMap)+len(unmappedFixtureHTTPStatusCodes), len(actual))
+	}
+
+	for i := range actual {
+		if i > 0 && actual[i-1].HTTPStatusCode >= actual[i].HTTPStatusCode {
+			t.Errorf("index %d: expectation is ascending order, got %d after %d", i, actual[i].HTTPStatusCode, actual[i-1].HTTPStatusCode)
+		}
+
+		if GRPCCodeFromHTTPStatusCode(actual[i].HTTPStatusCode) != actual[i].GRPCCode {
+			t.Errorf("%d: expectation grpc code is %d, got %d", actual[i].HTTPStatusCode, GRPCCodeFromHTTPStatusCode(actual[i].HTTPStatusCode), actual[i].GRPCCode)
+		}
+
+		if HTTPStatusCodeFromGRPCCode(actual[i].GRPCCode) != actual[i].RoundTripHTTPStatusCode {
+			t.Errorf("%d: expectation round trip is %d, got %d", actual[i].HTTPStatusCode, HTTPStatusCodeFromGRPCCode(actual[i].GRPCCode), actual[i].RoundTripHTTPStatusCode)
+		}
+	}
+
+	var expectation Fixture = Fixture{
+		HTTPStatusCode:          http.StatusCreated,
+		GRPCCode:                codes.OK,
+		RoundTripHTTPStatusCode: http.StatusOK,
+	}
+
+	if expectation != actual[1] {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual[1])
+	}
+}
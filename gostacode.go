@@ -1,6 +1,7 @@
 package gostacode
 
 import (
+	"fmt"
 	"net/http"
 
 	"google.golang.org/grpc/codes"
@@ -16,6 +17,7 @@ var (
 		http.StatusForbidden:       codes.PermissionDenied,
 		http.StatusNotFound:        codes.NotFound,
 		http.StatusConflict:        codes.AlreadyExists,
+		http.StatusGone:            codes.NotFound,
 		http.StatusTooManyRequests: codes.ResourceExhausted,
 
 		http.StatusInternalServerError: codes.Internal,
@@ -45,30 +47,86 @@ var (
 	}
 )
 
+var (
+	defaultConverter *Converter = NewConverter()
+)
+
+// GRPCCodeFromHTTPStatusCodeOK is (*Converter).GRPCCodeOK on the default converter.
+func GRPCCodeFromHTTPStatusCodeOK(httpStatusCode int) (codes.Code, bool) {
+	return defaultConverter.GRPCCodeOK(httpStatusCode)
+}
+
+// HTTPStatusCodeFromGRPCCodeOK is (*Converter).HTTPStatusCodeOK on the default converter.
+func HTTPStatusCodeFromGRPCCodeOK(grpcCode codes.Code) (int, bool) {
+	return defaultConverter.HTTPStatusCodeOK(grpcCode)
+}
+
+// GRPCCodeFromHTTPStatusCodeOrDefault is (*Converter).GRPCCodeOrDefault on the default converter.
+func GRPCCodeFromHTTPStatusCodeOrDefault(httpStatusCode int, fallback codes.Code) codes.Code {
+	return defaultConverter.GRPCCodeOrDefault(httpStatusCode, fallback)
+}
+
+// HTTPStatusCodeFromGRPCCodeOrDefault is (*Converter).HTTPStatusCodeOrDefault on the default converter.
+func HTTPStatusCodeFromGRPCCodeOrDefault(grpcCode codes.Code, fallback int) int {
+	return defaultConverter.HTTPStatusCodeOrDefault(grpcCode, fallback)
+}
+
 func GRPCCodeFromHTTPStatusCode(httpStatusCode int) codes.Code {
-	var (
-		grpcCode codes.Code
-		ok       bool
-	)
-
-	grpcCode, ok = httpGRPCCodeMap[httpStatusCode]
-	if !ok {
-		return codes.Unknown
+	return defaultConverter.GRPCCode(httpStatusCode)
+}
+
+// GRPCCodeFromHTTPStatusCodeStrict is GRPCCodeFromHTTPStatusCode for inputs in the 100 to 599 range.
+// Any other input returns codes.Unknown and an error wrapping ErrInvalidHTTPStatusCode.
+func GRPCCodeFromHTTPStatusCodeStrict(httpStatusCode int) (codes.Code, error) {
+	if !IsValidHTTPStatusCode(httpStatusCode) {
+		return codes.Unknown, fmt.Errorf("%w: %d", ErrInvalidHTTPStatusCode, httpStatusCode)
 	}
 
-	return grpcCode
+	return GRPCCodeFromHTTPStatusCode(httpStatusCode), nil
 }
 
-func HTTPStatusCodeFromGRPCCode(grpcCode codes.Code) int {
-	var (
-		httpStatusCode int
-		ok             bool
-	)
-
-	httpStatusCode, ok = grpcHTTPCodeMap[grpcCode]
-	if !ok {
-		return http.StatusInternalServerError
+// GRPCCodeFromHTTPStatusCodeWithSuccessMax maps httpStatusCode like GRPCCodeFromHTTPStatusCode,
+// except that any code from 200 up to, but excluding, successMax is treated as codes.OK.
+// For example, a successMax of 400 makes every 2xx and 3xx code OK.
+func GRPCCodeFromHTTPStatusCodeWithSuccessMax(httpStatusCode, successMax int) codes.Code {
+	if httpStatusCode >= 200 && httpStatusCode < successMax {
+		return codes.OK
 	}
 
-	return httpStatusCode
+	return GRPCCodeFromHTTPStatusCode(httpStatusCode)
+}
+
+// GRPCCodeFromHTTPStatusCodeWithRanges is (*Converter).GRPCCodeWithRanges on the default converter.
+func GRPCCodeFromHTTPStatusCodeWithRanges(httpStatusCode int) codes.Code {
+	return defaultConverter.GRPCCodeWithRanges(httpStatusCode)
+}
+
+func HTTPStatusCodeFromGRPCCode(grpcCode codes.Code) int {
+	return defaultConverter.HTTPStatusCode(grpcCode)
+}
+
+// Configure is (*Converter).Configure on the default converter,
+// which the package-level functions convert with.
+func Configure(opts ...Option) {
+	defaultConverter.Configure(opts...)
+}
+
+// RangeHTTPToGRPC is (*Converter).RangeHTTPToGRPC on the default converter.
+func RangeHTTPToGRPC(fn func(httpStatusCode int, grpcCode codes.Code) bool) {
+	defaultConverter.RangeHTTPToGRPC(fn)
+}
+
+// RangeGRPCToHTTP is (*Converter).RangeGRPCToHTTP on the default converter.
+func RangeGRPCToHTTP(fn func(grpcCode codes.Code, httpStatusCode int) bool) {
+	defaultConverter.RangeGRPCToHTTP(fn)
+}
+
+// HTTPToGRPCMappings is (*Converter).HTTPToGRPCMappings on the default converter.
+func HTTPToGRPCMappings() map[int]codes.Code {
+	return defaultConverter.HTTPToGRPCMappings()
+}
+
+// GRPCToHTTPMappings is (*Converter).GRPCToHTTPMappings on the default converter.
+func GRPCToHTTPMappings() map[codes.Code]int {
+	return defaultConverter.GRPCToHTTPMappings()
 }
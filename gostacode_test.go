@@ -1,6 +1,7 @@
 package gostacode
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 
@@ -32,6 +33,26 @@ func TestGRPCCodeFromHTTPStatusCode(t *testing.T) {
 			HTTPStatusCode: http.StatusCreated,
 			Expectation:    codes.OK,
 		},
+		{
+			Name:           http.StatusText(http.StatusNoContent),
+			HTTPStatusCode: http.StatusNoContent,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           "255",
+			HTTPStatusCode: 255,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           "299",
+			HTTPStatusCode: 299,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           http.StatusText(http.StatusMultipleChoices),
+			HTTPStatusCode: http.StatusMultipleChoices,
+			Expectation:    codes.Unknown,
+		},
 		{
 			Name:           http.StatusText(http.StatusBadRequest),
 			HTTPStatusCode: http.StatusBadRequest,
@@ -57,6 +78,11 @@ func TestGRPCCodeFromHTTPStatusCode(t *testing.T) {
 			HTTPStatusCode: http.StatusConflict,
 			Expectation:    codes.AlreadyExists,
 		},
+		{
+			Name:           http.StatusText(http.StatusGone),
+			HTTPStatusCode: http.StatusGone,
+			Expectation:    codes.NotFound,
+		},
 		{
 			Name:           http.StatusText(http.StatusTooManyRequests),
 			HTTPStatusCode: http.StatusTooManyRequests,
@@ -92,6 +118,21 @@ func TestGRPCCodeFromHTTPStatusCode(t *testing.T) {
 			HTTPStatusCode: http.StatusGatewayTimeout,
 			Expectation:    codes.DeadlineExceeded,
 		},
+		{
+			Name:           "0",
+			HTTPStatusCode: 0,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "-1",
+			HTTPStatusCode: -1,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "999",
+			HTTPStatusCode: 999,
+			Expectation:    codes.Unknown,
+		},
 	}
 
 	for i := range testCases {
@@ -212,3 +253,477 @@ func TestHTTPStatusCodeFromGRPCCode(t *testing.T) {
 		})
 	}
 }
+
+func TestRangeHTTPToGRPC(t *testing.T) {
+	t.Run("all entries", func(t *testing.T) {
+		var actual int
+
+		RangeHTTPToGRPC(func(httpStatusCode int, grpcCode codes.Code) bool {
+			if GRPCCodeFromHTTPStatusCode(httpStatusCode) != grpcCode {
+				t.Errorf("expectation is %d, got %d", GRPCCodeFromHTTPStatusCode(httpStatusCode), grpcCode)
+			}
+
+			actual++
+			return true
+		})
+
+		if len(httpGRPCCodeMap) != actual {
+			t.Errorf("expectation is %d, got %d", len(httpGRPCCodeMap), actual)
+		}
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		var actual int
+
+		RangeHTTPToGRPC(func(httpStatusCode int, grpcCode codes.Code) bool {
+			actual++
+			return actual < 2
+		})
+
+		if actual != 2 {
+			t.Errorf("expectation is %d, got %d", 2, actual)
+		}
+	})
+}
+
+func TestRangeGRPCToHTTP(t *testing.T) {
+	t.Run("all entries", func(t *testing.T) {
+		var actual int
+
+		RangeGRPCToHTTP(func(grpcCode codes.Code, httpStatusCode int) bool {
+			if HTTPStatusCodeFromGRPCCode(grpcCode) != httpStatusCode {
+				t.Errorf("expectation is %d, got %d", HTTPStatusCodeFromGRPCCode(grpcCode), httpStatusCode)
+			}
+
+			actual++
+			return true
+		})
+
+		if len(grpcHTTPCodeMap) != actual {
+			t.Errorf("expectation is %d, got %d", len(grpcHTTPCodeMap), actual)
+		}
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		var actual int
+
+		RangeGRPCToHTTP(func(grpcCode codes.Code, httpStatusCode int) bool {
+			actual++
+			return actual < 2
+		})
+
+		if actual != 2 {
+			t.Errorf("expectation is %d, got %d", 2, actual)
+		}
+	})
+}
+
+func TestGRPCCodeFromHTTPStatusCodeOK(t *testing.T) {
+	var testCases []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	} = []struct {
+		Name                string
+		HTTPStatusCode      int
+		ExpectationGRPCCode codes.Code
+		ExpectationOK       bool
+	}{
+		{
+			Name:                http.StatusText(http.StatusNotFound),
+			HTTPStatusCode:      http.StatusNotFound,
+			ExpectationGRPCCode: codes.NotFound,
+			ExpectationOK:       true,
+		},
+		{
+			Name:                http.StatusText(http.StatusNoContent),
+			HTTPStatusCode:      http.StatusNoContent,
+			ExpectationGRPCCode: codes.OK,
+			ExpectationOK:       false,
+		},
+		{
+			Name:                http.StatusText(http.StatusTeapot),
+			HTTPStatusCode:      http.StatusTeapot,
+			ExpectationGRPCCode: codes.Unknown,
+			ExpectationOK:       false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualGRPCCode codes.Code
+				actualOK       bool
+			)
+
+			actualGRPCCode, actualOK = GRPCCodeFromHTTPStatusCodeOK(testCases[i].HTTPStatusCode)
+
+			if testCases[i].ExpectationGRPCCode != actualGRPCCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationGRPCCode, actualGRPCCode)
+			}
+
+			if testCases[i].ExpectationOK != actualOK {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationOK, actualOK)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodeFromGRPCCodeOK(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationOK             bool
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationOK             bool
+	}{
+		{
+			Name:                      codes.NotFound.String(),
+			GRPCCode:                  codes.NotFound,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationOK:             true,
+		},
+		{
+			Name:                      codes.Canceled.String(),
+			GRPCCode:                  codes.Canceled,
+			ExpectationHTTPStatusCode: http.StatusInternalServerError,
+			ExpectationOK:             false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualOK             bool
+			)
+
+			actualHTTPStatusCode, actualOK = HTTPStatusCodeFromGRPCCodeOK(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationHTTPStatusCode != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actualHTTPStatusCode)
+			}
+
+			if testCases[i].ExpectationOK != actualOK {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationOK, actualOK)
+			}
+		})
+	}
+}
+
+func TestGRPCCodeFromHTTPStatusCodeOrDefault(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Fallback       codes.Code
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Fallback       codes.Code
+		Expectation    codes.Code
+	}{
+		{
+			Name:           http.StatusText(http.StatusNotFound),
+			HTTPStatusCode: http.StatusNotFound,
+			Fallback:       codes.Internal,
+			Expectation:    codes.NotFound,
+		},
+		{
+			Name:           http.StatusText(http.StatusTeapot),
+			HTTPStatusCode: http.StatusTeapot,
+			Fallback:       codes.Internal,
+			Expectation:    codes.Internal,
+		},
+		{
+			Name:           http.StatusText(http.StatusAccepted),
+			HTTPStatusCode: http.StatusAccepted,
+			Fallback:       codes.Internal,
+			Expectation:    codes.OK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPStatusCodeOrDefault(testCases[i].HTTPStatusCode, testCases[i].Fallback)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodeFromGRPCCodeOrDefault(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Fallback    int
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Fallback    int
+		Expectation int
+	}{
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Fallback:    http.StatusBadGateway,
+			Expectation: http.StatusNotFound,
+		},
+		{
+			Name:        codes.Canceled.String(),
+			GRPCCode:    codes.Canceled,
+			Fallback:    http.StatusBadGateway,
+			Expectation: http.StatusBadGateway,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusCodeFromGRPCCodeOrDefault(testCases[i].GRPCCode, testCases[i].Fallback)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestGRPCCodeFromHTTPStatusCodeWithRanges(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           http.StatusText(http.StatusTeapot),
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           http.StatusText(http.StatusUnavailableForLegalReasons),
+			HTTPStatusCode: http.StatusUnavailableForLegalReasons,
+			Expectation:    codes.InvalidArgument,
+		},
+		{
+			Name:           http.StatusText(http.StatusInsufficientStorage),
+			HTTPStatusCode: http.StatusInsufficientStorage,
+			Expectation:    codes.Internal,
+		},
+		{
+			Name:           "599",
+			HTTPStatusCode: 599,
+			Expectation:    codes.Internal,
+		},
+		{
+			Name:           http.StatusText(http.StatusNotFound),
+			HTTPStatusCode: http.StatusNotFound,
+			Expectation:    codes.NotFound,
+		},
+		{
+			Name:           http.StatusText(http.StatusServiceUnavailable),
+			HTTPStatusCode: http.StatusServiceUnavailable,
+			Expectation:    codes.Unavailable,
+		},
+		{
+			Name:           http.StatusText(http.StatusFound),
+			HTTPStatusCode: http.StatusFound,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "999",
+			HTTPStatusCode: 999,
+			Expectation:    codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPStatusCodeWithRanges(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestHTTPToGRPCMappings(t *testing.T) {
+	var actual map[int]codes.Code = HTTPToGRPCMappings()
+
+	if len(httpGRPCCodeMap) != len(actual) {
+		t.Fatalf("expectation length is %d, got %d", len(httpGRPCCodeMap), len(actual))
+	}
+
+	for httpStatusCode, grpcCode := range httpGRPCCodeMap {
+		if grpcCode != actual[httpStatusCode] {
+			t.Errorf("%d: expectation is %d, got %d", httpStatusCode, grpcCode, actual[httpStatusCode])
+		}
+	}
+
+	actual[http.StatusNotFound] = codes.Internal
+	actual[http.StatusTeapot] = codes.InvalidArgument
+
+	if GRPCCodeFromHTTPStatusCode(http.StatusNotFound) != codes.NotFound {
+		t.Errorf("expectation is %d, got %d", codes.NotFound, GRPCCodeFromHTTPStatusCode(http.StatusNotFound))
+	}
+
+	if GRPCCodeFromHTTPStatusCode(http.StatusTeapot) != codes.Unknown {
+		t.Errorf("expectation is %d, got %d", codes.Unknown, GRPCCodeFromHTTPStatusCode(http.StatusTeapot))
+	}
+}
+
+func TestGRPCToHTTPMappings(t *testing.T) {
+	var actual map[codes.Code]int = GRPCToHTTPMappings()
+
+	if len(grpcHTTPCodeMap) != len(actual) {
+		t.Fatalf("expectation length is %d, got %d", len(grpcHTTPCodeMap), len(actual))
+	}
+
+	for grpcCode, httpStatusCode := range grpcHTTPCodeMap {
+		if httpStatusCode != actual[grpcCode] {
+			t.Errorf("%s: expectation is %d, got %d", grpcCode, httpStatusCode, actual[grpcCode])
+		}
+	}
+
+	actual[codes.NotFound] = http.StatusGone
+	actual[codes.Internal] = http.StatusBadGateway
+
+	if HTTPStatusCodeFromGRPCCode(codes.NotFound) != http.StatusNotFound {
+		t.Errorf("expectation is %d, got %d", http.StatusNotFound, HTTPStatusCodeFromGRPCCode(codes.NotFound))
+	}
+
+	if HTTPStatusCodeFromGRPCCode(codes.Internal) != http.StatusInternalServerError {
+		t.Errorf("expectation is %d, got %d", http.StatusInternalServerError, HTTPStatusCodeFromGRPCCode(codes.Internal))
+	}
+}
+
+func TestGRPCCodeFromHTTPStatusCodeStrict(t *testing.T) {
+	var testCases []struct {
+		Name             string
+		HTTPStatusCode   int
+		Expectation      codes.Code
+		ExpectationError error
+	} = []struct {
+		Name             string
+		HTTPStatusCode   int
+		Expectation      codes.Code
+		ExpectationError error
+	}{
+		{
+			Name:             "99",
+			HTTPStatusCode:   99,
+			Expectation:      codes.Unknown,
+			ExpectationError: ErrInvalidHTTPStatusCode,
+		},
+		{
+			Name:             "600",
+			HTTPStatusCode:   600,
+			Expectation:      codes.Unknown,
+			ExpectationError: ErrInvalidHTTPStatusCode,
+		},
+		{
+			Name:             http.StatusText(http.StatusOK),
+			HTTPStatusCode:   http.StatusOK,
+			Expectation:      codes.OK,
+			ExpectationError: nil,
+		},
+		{
+			Name:             http.StatusText(http.StatusNotFound),
+			HTTPStatusCode:   http.StatusNotFound,
+			Expectation:      codes.NotFound,
+			ExpectationError: nil,
+		},
+		{
+			Name:             http.StatusText(http.StatusTeapot),
+			HTTPStatusCode:   http.StatusTeapot,
+			Expectation:      codes.Unknown,
+			ExpectationError: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual      codes.Code
+				actualError error
+			)
+
+			actual, actualError = GRPCCodeFromHTTPStatusCodeStrict(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+
+			if !errors.Is(actualError, testCases[i].ExpectationError) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].ExpectationError, actualError)
+			}
+		})
+	}
+}
+
+func TestGRPCCodeFromHTTPStatusCodeWithSuccessMax(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		SuccessMax     int
+		Expectation    codes.Code
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		SuccessMax     int
+		Expectation    codes.Code
+	}{
+		{
+			Name:           "302 with success max 400",
+			HTTPStatusCode: http.StatusFound,
+			SuccessMax:     400,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           "302 with success max 300",
+			HTTPStatusCode: http.StatusFound,
+			SuccessMax:     300,
+			Expectation:    codes.Unknown,
+		},
+		{
+			Name:           "404 with success max 400",
+			HTTPStatusCode: http.StatusNotFound,
+			SuccessMax:     400,
+			Expectation:    codes.NotFound,
+		},
+		{
+			Name:           "201 with success max 300",
+			HTTPStatusCode: http.StatusCreated,
+			SuccessMax:     300,
+			Expectation:    codes.OK,
+		},
+		{
+			Name:           "100 with success max 400",
+			HTTPStatusCode: http.StatusContinue,
+			SuccessMax:     400,
+			Expectation:    codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPStatusCodeWithSuccessMax(testCases[i].HTTPStatusCode, testCases[i].SuccessMax)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	grpcGraphQLErrorCodeMap map[codes.Code]string = map[codes.Code]string{
+		codes.Unauthenticated:  "UNAUTHENTICATED",
+		codes.PermissionDenied: "FORBIDDEN",
+		codes.InvalidArgument:  "BAD_USER_INPUT",
+		codes.NotFound:         "NOT_FOUND",
+		codes.Unknown:          "INTERNAL_SERVER_ERROR",
+		codes.Internal:         "INTERNAL_SERVER_ERROR",
+		codes.DataLoss:         "INTERNAL_SERVER_ERROR",
+	}
+)
+
+// GraphQLErrorCodeFromGRPCCode returns the Apollo-style extensions.code for grpcCode.
+// Codes without a GraphQL counterpart map to "INTERNAL_SERVER_ERROR".
+func GraphQLErrorCodeFromGRPCCode(grpcCode codes.Code) string {
+	var (
+		graphQLErrorCode string
+		ok               bool
+	)
+
+	graphQLErrorCode, ok = grpcGraphQLErrorCodeMap[grpcCode]
+	if !ok {
+		return "INTERNAL_SERVER_ERROR"
+	}
+
+	return graphQLErrorCode
+}
@@ -0,0 +1,60 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGraphQLErrorCodeFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	}{
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: "UNAUTHENTICATED",
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: "FORBIDDEN",
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: "BAD_USER_INPUT",
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: "NOT_FOUND",
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: "INTERNAL_SERVER_ERROR",
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: "INTERNAL_SERVER_ERROR",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = GraphQLErrorCodeFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gostacode
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TrailerFromHTTPStatus returns gRPC trailer metadata with grpc-status set to the gRPC code mapped from httpStatusCode
+// and grpc-message set to message, or to the status text of httpStatusCode when message is empty.
+func TrailerFromHTTPStatus(httpStatusCode int, message string) metadata.MD {
+	var grpcCode codes.Code = GRPCCodeFromHTTPStatusCode(httpStatusCode)
+
+	if message == "" {
+		message = http.StatusText(httpStatusCode)
+	}
+
+	return metadata.Pairs(
+		"grpc-status", strconv.Itoa(int(grpcCode)),
+		"grpc-message", message,
+	)
+}
+
+// HTTPStatusCodeFromError returns the HTTP status code mapped from the gRPC code carried by err,
+// which may be wrapped. A nil err maps to http.StatusOK and an err without a gRPC status is treated as codes.Unknown.
+func HTTPStatusCodeFromError(err error) int {
+	return HTTPStatusCodeFromGRPCCode(status.Code(err))
+}
+
+// HTTPStatusUnaryInterceptor returns a grpc.UnaryServerInterceptor that sets the x-http-status trailer
+// to the HTTP status code mapped from the code of the error returned by the handler, or to 200 when it returns nil.
+// The handler's response and error are returned unchanged.
+func HTTPStatusUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var (
+			resp any
+			err  error
+		)
+
+		resp, err = handler(ctx, req)
+
+		grpc.SetTrailer(ctx, metadata.Pairs("x-http-status", strconv.Itoa(HTTPStatusCodeFromError(err))))
+
+		return resp, err
+	}
+}
@@ -0,0 +1,175 @@
+package gostacode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrailerFromHTTPStatus(t *testing.T) {
+	var testCases []struct {
+		Name                   string
+		HTTPStatusCode         int
+		Message                string
+		ExpectationGRPCStatus  string
+		ExpectationGRPCMessage string
+	} = []struct {
+		Name                   string
+		HTTPStatusCode         int
+		Message                string
+		ExpectationGRPCStatus  string
+		ExpectationGRPCMessage string
+	}{
+		{
+			Name:                   "not found with message",
+			HTTPStatusCode:         http.StatusNotFound,
+			Message:                "user not found",
+			ExpectationGRPCStatus:  "5",
+			ExpectationGRPCMessage: "user not found",
+		},
+		{
+			Name:                   "unavailable without message",
+			HTTPStatusCode:         http.StatusServiceUnavailable,
+			Message:                "",
+			ExpectationGRPCStatus:  "14",
+			ExpectationGRPCMessage: http.StatusText(http.StatusServiceUnavailable),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual metadata.MD = TrailerFromHTTPStatus(testCases[i].HTTPStatusCode, testCases[i].Message)
+
+			if len(actual.Get("grpc-status")) != 1 || testCases[i].ExpectationGRPCStatus != actual.Get("grpc-status")[0] {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationGRPCStatus, actual.Get("grpc-status"))
+			}
+
+			if len(actual.Get("grpc-message")) != 1 || testCases[i].ExpectationGRPCMessage != actual.Get("grpc-message")[0] {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationGRPCMessage, actual.Get("grpc-message"))
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodeFromError(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Err         error
+		Expectation int
+	} = []struct {
+		Name        string
+		Err         error
+		Expectation int
+	}{
+		{
+			Name:        "status error",
+			Err:         status.Error(codes.NotFound, "user not found"),
+			Expectation: http.StatusNotFound,
+		},
+		{
+			Name:        "wrapped status error",
+			Err:         fmt.Errorf("get user: %w", status.Error(codes.PermissionDenied, "denied")),
+			Expectation: http.StatusForbidden,
+		},
+		{
+			Name:        "plain error",
+			Err:         errors.New("boom"),
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        "nil",
+			Err:         nil,
+			Expectation: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusCodeFromError(testCases[i].Err)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+type fakeServerTransportStream struct {
+	trailer metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return "/test.Service/Method"
+}
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+func (s *fakeServerTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+
+	return nil
+}
+
+func TestHTTPStatusUnaryInterceptor(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		HandlerErr  error
+		Expectation string
+	} = []struct {
+		Name        string
+		HandlerErr  error
+		Expectation string
+	}{
+		{
+			Name:        "success",
+			HandlerErr:  nil,
+			Expectation: "200",
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			HandlerErr:  status.Error(codes.InvalidArgument, "name is required"),
+			Expectation: "400",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				stream  *fakeServerTransportStream = &fakeServerTransportStream{}
+				ctx     context.Context            = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+				handler grpc.UnaryHandler          = func(ctx context.Context, req any) (any, error) {
+					return "response", testCases[i].HandlerErr
+				}
+				actualResp any
+				actualErr  error
+			)
+
+			actualResp, actualErr = HTTPStatusUnaryInterceptor()(ctx, "request", &grpc.UnaryServerInfo{}, handler)
+
+			if actualResp != "response" {
+				t.Errorf("expectation response is %q, got %v", "response", actualResp)
+			}
+
+			if testCases[i].HandlerErr != actualErr {
+				t.Errorf("expectation error is %v, got %v", testCases[i].HandlerErr, actualErr)
+			}
+
+			if len(stream.trailer.Get("x-http-status")) != 1 || testCases[i].Expectation != stream.trailer.Get("x-http-status")[0] {
+				t.Errorf("expectation is %q, got %v", testCases[i].Expectation, stream.trailer.Get("x-http-status"))
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthStatus is the serving status reported by the gRPC health checking protocol.
+type HealthStatus = grpc_health_v1.HealthCheckResponse_ServingStatus
+
+var (
+	healthStatusHTTPCodeMap map[HealthStatus]int = map[HealthStatus]int{
+		grpc_health_v1.HealthCheckResponse_UNKNOWN:         http.StatusInternalServerError,
+		grpc_health_v1.HealthCheckResponse_SERVING:         http.StatusOK,
+		grpc_health_v1.HealthCheckResponse_NOT_SERVING:     http.StatusServiceUnavailable,
+		grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN: http.StatusNotFound,
+	}
+)
+
+// HTTPStatusFromHealthStatus returns the HTTP status code an HTTP health endpoint should answer with for s.
+// Unrecognized serving statuses map to 500 Internal Server Error.
+func HTTPStatusFromHealthStatus(s HealthStatus) int {
+	var (
+		httpStatusCode int
+		ok             bool
+	)
+
+	httpStatusCode, ok = healthStatusHTTPCodeMap[s]
+	if !ok {
+		return http.StatusInternalServerError
+	}
+
+	return httpStatusCode
+}
@@ -0,0 +1,56 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHTTPStatusFromHealthStatus(t *testing.T) {
+	var testCases []struct {
+		Name         string
+		HealthStatus HealthStatus
+		Expectation  int
+	} = []struct {
+		Name         string
+		HealthStatus HealthStatus
+		Expectation  int
+	}{
+		{
+			Name:         grpc_health_v1.HealthCheckResponse_SERVING.String(),
+			HealthStatus: grpc_health_v1.HealthCheckResponse_SERVING,
+			Expectation:  http.StatusOK,
+		},
+		{
+			Name:         grpc_health_v1.HealthCheckResponse_NOT_SERVING.String(),
+			HealthStatus: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+			Expectation:  http.StatusServiceUnavailable,
+		},
+		{
+			Name:         grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN.String(),
+			HealthStatus: grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN,
+			Expectation:  http.StatusNotFound,
+		},
+		{
+			Name:         grpc_health_v1.HealthCheckResponse_UNKNOWN.String(),
+			HealthStatus: grpc_health_v1.HealthCheckResponse_UNKNOWN,
+			Expectation:  http.StatusInternalServerError,
+		},
+		{
+			Name:         "unrecognized",
+			HealthStatus: HealthStatus(99),
+			Expectation:  http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusFromHealthStatus(testCases[i].HealthStatus)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
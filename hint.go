@@ -0,0 +1,52 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	grpcRecoveryHintMap map[codes.Code]string = map[codes.Code]string{
+		codes.Unavailable:       "retry",
+		codes.DeadlineExceeded:  "retry",
+		codes.Aborted:           "retry",
+		codes.ResourceExhausted: "retry later",
+
+		codes.Unauthenticated:    "re-authenticate",
+		codes.PermissionDenied:   "request access",
+		codes.InvalidArgument:    "fix request",
+		codes.OutOfRange:         "fix request",
+		codes.FailedPrecondition: "fix request",
+
+		codes.Unknown:  "contact support",
+		codes.Internal: "contact support",
+		codes.DataLoss: "contact support",
+	}
+)
+
+// WithRecoveryHintForGRPCCode overrides the hint RecoveryHint returns for grpcCode.
+func WithRecoveryHintForGRPCCode(grpcCode codes.Code, hint string) Option {
+	return func(s *converterState) {
+		s.recoveryHints[grpcCode] = hint
+	}
+}
+
+// RecoveryHint returns the recovery action to suggest to the user for grpcCode,
+// or an empty string when there is nothing sensible to suggest, unless overridden with WithRecoveryHintForGRPCCode.
+func (c *Converter) RecoveryHint(grpcCode codes.Code) string {
+	var (
+		hint string
+		ok   bool
+	)
+
+	hint, ok = c.state.Load().recoveryHints[grpcCode]
+	if ok {
+		return hint
+	}
+
+	return grpcRecoveryHintMap[grpcCode]
+}
+
+// RecoveryHintForGRPCCode is (*Converter).RecoveryHint on the default converter.
+func RecoveryHintForGRPCCode(grpcCode codes.Code) string {
+	return defaultConverter.RecoveryHint(grpcCode)
+}
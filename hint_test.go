@@ -0,0 +1,77 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestRecoveryHintForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	}{
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: "retry",
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: "re-authenticate",
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: "fix request",
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: "contact support",
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: "contact support",
+		},
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = RecoveryHintForGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithRecoveryHintForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithRecoveryHintForGRPCCode(codes.NotFound, "check the identifier"))
+		actual string     = c.RecoveryHint(codes.NotFound)
+	)
+
+	if actual != "check the identifier" {
+		t.Errorf("expectation is %q, got %q", "check the identifier", actual)
+	}
+
+	actual = RecoveryHintForGRPCCode(codes.NotFound)
+
+	if actual != "" {
+		t.Errorf("expectation is %q, got %q", "", actual)
+	}
+}
@@ -0,0 +1,40 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// HTTP/2 error codes as defined by RFC 9113 section 7.
+const (
+	http2ErrCodeNoError         uint32 = 0x0
+	http2ErrCodeInternalError   uint32 = 0x2
+	http2ErrCodeRefusedStream   uint32 = 0x7
+	http2ErrCodeCancel          uint32 = 0x8
+	http2ErrCodeEnhanceYourCalm uint32 = 0xb
+)
+
+var (
+	http2ErrCodeGRPCCodeMap map[uint32]codes.Code = map[uint32]codes.Code{
+		http2ErrCodeNoError:         codes.OK,
+		http2ErrCodeInternalError:   codes.Internal,
+		http2ErrCodeRefusedStream:   codes.Unavailable,
+		http2ErrCodeCancel:          codes.Canceled,
+		http2ErrCodeEnhanceYourCalm: codes.ResourceExhausted,
+	}
+)
+
+// GRPCCodeFromHTTP2ErrorCode returns the gRPC code for an HTTP/2 RST_STREAM or GOAWAY error code (RFC 9113).
+// Error codes without a gRPC counterpart map to codes.Unknown.
+func GRPCCodeFromHTTP2ErrorCode(errCode uint32) codes.Code {
+	var (
+		grpcCode codes.Code
+		ok       bool
+	)
+
+	grpcCode, ok = http2ErrCodeGRPCCodeMap[errCode]
+	if !ok {
+		return codes.Unknown
+	}
+
+	return grpcCode
+}
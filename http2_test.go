@@ -0,0 +1,65 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodeFromHTTP2ErrorCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		ErrCode     uint32
+		Expectation codes.Code
+	} = []struct {
+		Name        string
+		ErrCode     uint32
+		Expectation codes.Code
+	}{
+		{
+			Name:        "NO_ERROR",
+			ErrCode:     0x0,
+			Expectation: codes.OK,
+		},
+		{
+			Name:        "INTERNAL_ERROR",
+			ErrCode:     0x2,
+			Expectation: codes.Internal,
+		},
+		{
+			Name:        "REFUSED_STREAM",
+			ErrCode:     0x7,
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "CANCEL",
+			ErrCode:     0x8,
+			Expectation: codes.Canceled,
+		},
+		{
+			Name:        "ENHANCE_YOUR_CALM",
+			ErrCode:     0xb,
+			Expectation: codes.ResourceExhausted,
+		},
+		{
+			Name:        "PROTOCOL_ERROR",
+			ErrCode:     0x1,
+			Expectation: codes.Unknown,
+		},
+		{
+			Name:        "unregistered",
+			ErrCode:     0xff,
+			Expectation: codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTP2ErrorCode(testCases[i].ErrCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	grpcHealthIndicatorMap map[codes.Code]string = map[codes.Code]string{
+		codes.OK: "green",
+
+		codes.Canceled:           "yellow",
+		codes.InvalidArgument:    "yellow",
+		codes.NotFound:           "yellow",
+		codes.AlreadyExists:      "yellow",
+		codes.PermissionDenied:   "yellow",
+		codes.Unauthenticated:    "yellow",
+		codes.FailedPrecondition: "yellow",
+		codes.Aborted:            "yellow",
+		codes.OutOfRange:         "yellow",
+		codes.ResourceExhausted:  "yellow",
+		codes.Unavailable:        "yellow",
+		codes.DeadlineExceeded:   "yellow",
+
+		codes.Unknown:       "red",
+		codes.Internal:      "red",
+		codes.DataLoss:      "red",
+		codes.Unimplemented: "red",
+	}
+)
+
+// WithHealthIndicatorForGRPCCode overrides the indicator HealthIndicator returns for grpcCode.
+func WithHealthIndicatorForGRPCCode(grpcCode codes.Code, indicator string) Option {
+	return func(s *converterState) {
+		s.healthIndicators[grpcCode] = indicator
+	}
+}
+
+// HealthIndicator returns the traffic-light indicator for grpcCode:
+// "green" for OK, "yellow" for client errors and transient server errors, and "red" otherwise,
+// unless overridden with WithHealthIndicatorForGRPCCode.
+func (c *Converter) HealthIndicator(grpcCode codes.Code) string {
+	var (
+		indicator string
+		ok        bool
+	)
+
+	indicator, ok = c.state.Load().healthIndicators[grpcCode]
+	if ok {
+		return indicator
+	}
+
+	indicator, ok = grpcHealthIndicatorMap[grpcCode]
+	if !ok {
+		return "red"
+	}
+
+	return indicator
+}
+
+// HealthIndicatorFromGRPCCode is (*Converter).HealthIndicator on the default converter.
+func HealthIndicatorFromGRPCCode(grpcCode codes.Code) string {
+	return defaultConverter.HealthIndicator(grpcCode)
+}
@@ -0,0 +1,72 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestHealthIndicatorFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation string
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: "green",
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: "yellow",
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: "yellow",
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: "red",
+		},
+		{
+			Name:        codes.Code(999).String(),
+			GRPCCode:    codes.Code(999),
+			Expectation: "red",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = HealthIndicatorFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithHealthIndicatorForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithHealthIndicatorForGRPCCode(codes.Unimplemented, "yellow"))
+		actual string     = c.HealthIndicator(codes.Unimplemented)
+	)
+
+	if actual != "yellow" {
+		t.Errorf("expectation is %q, got %q", "yellow", actual)
+	}
+
+	actual = HealthIndicatorFromGRPCCode(codes.Unimplemented)
+
+	if actual != "red" {
+		t.Errorf("expectation is %q, got %q", "red", actual)
+	}
+}
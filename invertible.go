@@ -0,0 +1,48 @@
+package gostacode
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// CodePair maps an HTTP status code and a gRPC code to each other.
+type CodePair struct {
+	HTTPStatusCode int
+	GRPCCode       codes.Code
+}
+
+// NewInvertibleConverter returns a Converter whose mapping tables hold exactly pairs, in both directions,
+// so every pair round-trips. Codes outside pairs resolve through the usual fallbacks,
+// except that unmapped 2xx codes are not resolved to codes.OK, since only pairs are mapped.
+// It returns an error wrapping ErrNotInvertible when an HTTP status code or a gRPC code
+// appears in two pairs with different counterparts, since one of them could not round-trip.
+func NewInvertibleConverter(pairs []CodePair) (*Converter, error) {
+	var (
+		httpToGRPC map[int]codes.Code = make(map[int]codes.Code, len(pairs))
+		grpcToHTTP map[codes.Code]int = make(map[codes.Code]int, len(pairs))
+	)
+
+	for i := range pairs {
+		var (
+			grpcCode       codes.Code
+			httpStatusCode int
+			ok             bool
+		)
+
+		grpcCode, ok = httpToGRPC[pairs[i].HTTPStatusCode]
+		if ok && grpcCode != pairs[i].GRPCCode {
+			return nil, fmt.Errorf("%w: http status code %d pairs with both %s and %s", ErrNotInvertible, pairs[i].HTTPStatusCode, grpcCode, pairs[i].GRPCCode)
+		}
+
+		httpStatusCode, ok = grpcToHTTP[pairs[i].GRPCCode]
+		if ok && httpStatusCode != pairs[i].HTTPStatusCode {
+			return nil, fmt.Errorf("%w: grpc code %s pairs with both %d and %d", ErrNotInvertible, pairs[i].GRPCCode, httpStatusCode, pairs[i].HTTPStatusCode)
+		}
+
+		httpToGRPC[pairs[i].HTTPStatusCode] = pairs[i].GRPCCode
+		grpcToHTTP[pairs[i].GRPCCode] = pairs[i].HTTPStatusCode
+	}
+
+	return newConverter(httpToGRPC, grpcToHTTP, withoutSuccessClassFallback()), nil
+}
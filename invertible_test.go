@@ -0,0 +1,113 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewInvertibleConverter(t *testing.T) {
+	var testCases []struct {
+		Name             string
+		Pairs            []CodePair
+		ExpectationError error
+	} = []struct {
+		Name             string
+		Pairs            []CodePair
+		ExpectationError error
+	}{
+		{
+			Name: "invertible",
+			Pairs: []CodePair{
+				{HTTPStatusCode: http.StatusOK, GRPCCode: codes.OK},
+				{HTTPStatusCode: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+				{HTTPStatusCode: http.StatusPreconditionFailed, GRPCCode: codes.FailedPrecondition},
+				{HTTPStatusCode: http.StatusNotFound, GRPCCode: codes.NotFound},
+				{HTTPStatusCode: http.StatusNotFound, GRPCCode: codes.NotFound},
+			},
+			ExpectationError: nil,
+		},
+		{
+			Name: "lossy grpc side",
+			Pairs: []CodePair{
+				{HTTPStatusCode: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+				{HTTPStatusCode: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition},
+			},
+			ExpectationError: ErrNotInvertible,
+		},
+		{
+			Name: "lossy http side",
+			Pairs: []CodePair{
+				{HTTPStatusCode: http.StatusBadGateway, GRPCCode: codes.Unavailable},
+				{HTTPStatusCode: http.StatusServiceUnavailable, GRPCCode: codes.Unavailable},
+			},
+			ExpectationError: ErrNotInvertible,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual      *Converter
+				actualError error
+			)
+
+			actual, actualError = NewInvertibleConverter(testCases[i].Pairs)
+
+			if !errors.Is(actualError, testCases[i].ExpectationError) {
+				t.Fatalf("expectation error is %v, got %v", testCases[i].ExpectationError, actualError)
+			}
+
+			if actualError != nil {
+				if actual != nil {
+					t.Errorf("expectation converter is nil, got %v", actual)
+				}
+
+				return
+			}
+
+			for j := range testCases[i].Pairs {
+				if testCases[i].Pairs[j].HTTPStatusCode != actual.RoundTripHTTP(testCases[i].Pairs[j].HTTPStatusCode) {
+					t.Errorf("%d: expectation is stable round trip, got %d", testCases[i].Pairs[j].HTTPStatusCode, actual.RoundTripHTTP(testCases[i].Pairs[j].HTTPStatusCode))
+				}
+
+				if testCases[i].Pairs[j].GRPCCode != actual.RoundTripGRPC(testCases[i].Pairs[j].GRPCCode) {
+					t.Errorf("%s: expectation is stable round trip, got %s", testCases[i].Pairs[j].GRPCCode, actual.RoundTripGRPC(testCases[i].Pairs[j].GRPCCode))
+				}
+			}
+
+			if codes.Unknown != actual.GRPCCode(http.StatusConflict) {
+				t.Errorf("expectation is only the pairs mapped, got %s for %d", actual.GRPCCode(http.StatusConflict), http.StatusConflict)
+			}
+		})
+	}
+}
+
+func TestNewInvertibleConverterUnpairedSuccessCode(t *testing.T) {
+	var (
+		c           *Converter
+		actualError error
+	)
+
+	c, actualError = NewInvertibleConverter([]CodePair{{HTTPStatusCode: http.StatusNotFound, GRPCCode: codes.NotFound}})
+	if actualError != nil {
+		t.Fatalf("expectation error is nil, got %v", actualError)
+	}
+
+	var httpStatusCodes []int = []int{http.StatusOK, http.StatusNoContent}
+
+	for i := range httpStatusCodes {
+		var (
+			actual   codes.Code
+			actualOK bool
+		)
+
+		actual, actualOK = c.GRPCCodeOK(httpStatusCodes[i])
+
+		if actual != codes.Unknown || actualOK {
+			t.Errorf("%d: expectation is (%s, %t), got (%s, %t)", httpStatusCodes[i], codes.Unknown, false, actual, actualOK)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package gostacode
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var (
+	// legacyOptions lists, per release tag, the options that undo the conversion changes made after that release.
+	legacyOptions map[string][]Option = map[string][]Option{
+		"v0.1.0": {
+			withoutHTTPToGRPC(http.StatusGone),
+			withoutSuccessClassFallback(),
+		},
+	}
+)
+
+// withoutHTTPToGRPC removes the HTTP to gRPC mappings of httpStatusCodes.
+func withoutHTTPToGRPC(httpStatusCodes ...int) Option {
+	return func(s *converterState) {
+		for i := range httpStatusCodes {
+			s.deleteHTTPToGRPC(httpStatusCodes[i])
+		}
+	}
+}
+
+// withoutSuccessClassFallback makes unmapped 2xx codes fall back like any other unmapped code
+// instead of resolving to codes.OK.
+func withoutSuccessClassFallback() Option {
+	return func(s *converterState) {
+		s.disableSuccessClassFallback = true
+	}
+}
+
+// LegacyConverter returns a Converter that reproduces the conversions of a prior release,
+// named by its release tag, so callers can pin the old behavior while migrating.
+//
+// Supported versions:
+//   - "v0.1.0": the initial release, before 410 Gone was mapped to codes.NotFound
+//     and before unmapped 2xx codes resolved to codes.OK instead of codes.Unknown.
+//
+// It returns ErrUnknownLegacyVersion for any other version.
+func LegacyConverter(version string) (*Converter, error) {
+	var (
+		opts []Option
+		ok   bool
+	)
+
+	opts, ok = legacyOptions[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLegacyVersion, version)
+	}
+
+	return NewConverter(opts...), nil
+}
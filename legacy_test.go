@@ -0,0 +1,78 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestLegacyConverter(t *testing.T) {
+	var (
+		c   *Converter
+		err error
+	)
+
+	c, err = LegacyConverter("v0.1.0")
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %v", err)
+	}
+
+	// v0.1.0 never mapped Canceled, same as the current tables, so both resolve it through the fallback.
+	var (
+		actualLegacyHTTPStatusCode  int = c.HTTPStatusCode(codes.Canceled)
+		actualCurrentHTTPStatusCode int = HTTPStatusCodeFromGRPCCode(codes.Canceled)
+	)
+
+	if actualLegacyHTTPStatusCode != http.StatusInternalServerError {
+		t.Errorf("expectation legacy is %d, got %d", http.StatusInternalServerError, actualLegacyHTTPStatusCode)
+	}
+
+	if actualCurrentHTTPStatusCode != http.StatusInternalServerError {
+		t.Errorf("expectation current is %d, got %d", http.StatusInternalServerError, actualCurrentHTTPStatusCode)
+	}
+
+	// 410 Gone is where v0.1.0 differs from the current tables.
+	var (
+		actualLegacyGRPCCode  codes.Code = c.GRPCCode(http.StatusGone)
+		actualCurrentGRPCCode codes.Code = GRPCCodeFromHTTPStatusCode(http.StatusGone)
+	)
+
+	if actualLegacyGRPCCode != codes.Unknown {
+		t.Errorf("expectation legacy is %d, got %d", codes.Unknown, actualLegacyGRPCCode)
+	}
+
+	if actualCurrentGRPCCode != codes.NotFound {
+		t.Errorf("expectation current is %d, got %d", codes.NotFound, actualCurrentGRPCCode)
+	}
+
+	// v0.1.0 resolved unmapped 2xx codes such as 204 No Content to codes.Unknown rather than codes.OK.
+	actualLegacyGRPCCode = c.GRPCCode(http.StatusNoContent)
+	actualCurrentGRPCCode = GRPCCodeFromHTTPStatusCode(http.StatusNoContent)
+
+	if actualLegacyGRPCCode != codes.Unknown {
+		t.Errorf("expectation legacy is %d, got %d", codes.Unknown, actualLegacyGRPCCode)
+	}
+
+	if actualCurrentGRPCCode != codes.OK {
+		t.Errorf("expectation current is %d, got %d", codes.OK, actualCurrentGRPCCode)
+	}
+}
+
+func TestLegacyConverterUnknownVersion(t *testing.T) {
+	var (
+		c   *Converter
+		err error
+	)
+
+	c, err = LegacyConverter("v1.0.0")
+
+	if c != nil {
+		t.Errorf("expectation converter is nil, got %v", c)
+	}
+
+	if !errors.Is(err, ErrUnknownLegacyVersion) {
+		t.Errorf("expectation error is %v, got %v", ErrUnknownLegacyVersion, err)
+	}
+}
@@ -0,0 +1,46 @@
+package gostacode
+
+import (
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WithLogLevelForGRPCCode overrides the level LogLevel returns for grpcCode.
+func WithLogLevelForGRPCCode(grpcCode codes.Code, level slog.Level) Option {
+	return func(s *converterState) {
+		s.logLevels[grpcCode] = level
+	}
+}
+
+// LogLevel returns the slog level to log an outcome of grpcCode with:
+// slog.LevelInfo for OK, slog.LevelWarn for codes mapped to a 4xx status,
+// and slog.LevelError for codes mapped to a 5xx status, unless overridden with WithLogLevelForGRPCCode.
+func (c *Converter) LogLevel(grpcCode codes.Code) slog.Level {
+	var (
+		s     *converterState = c.state.Load()
+		level slog.Level
+		ok    bool
+	)
+
+	level, ok = s.logLevels[grpcCode]
+	if ok {
+		return level
+	}
+
+	if grpcCode == codes.OK {
+		return slog.LevelInfo
+	}
+
+	if LookupOrDefault(s.grpcToHTTP, grpcCode, http.StatusInternalServerError) < http.StatusInternalServerError {
+		return slog.LevelWarn
+	}
+
+	return slog.LevelError
+}
+
+// LogLevelFromGRPCCode is (*Converter).LogLevel on the default converter.
+func LogLevelFromGRPCCode(grpcCode codes.Code) slog.Level {
+	return defaultConverter.LogLevel(grpcCode)
+}
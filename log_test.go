@@ -0,0 +1,73 @@
+package gostacode
+
+import (
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestLogLevelFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation slog.Level
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation slog.Level
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: slog.LevelInfo,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: slog.LevelWarn,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: slog.LevelWarn,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: slog.LevelError,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: slog.LevelError,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual slog.Level = LogLevelFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithLogLevelForGRPCCode(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithLogLevelForGRPCCode(codes.NotFound, slog.LevelDebug))
+		actual slog.Level = c.LogLevel(codes.NotFound)
+	)
+
+	if actual != slog.LevelDebug {
+		t.Errorf("expectation is %s, got %s", slog.LevelDebug, actual)
+	}
+
+	actual = LogLevelFromGRPCCode(codes.NotFound)
+
+	if actual != slog.LevelWarn {
+		t.Errorf("expectation is %s, got %s", slog.LevelWarn, actual)
+	}
+}
@@ -0,0 +1,17 @@
+package gostacode
+
+// LookupOrDefault returns the value of key in m, or fallback when m has no entry for key.
+// It gives auxiliary tables, such as a code to log level table, the same fallback semantics as the conversions.
+func LookupOrDefault[K comparable, V any](m map[K]V, key K, fallback V) V {
+	var (
+		value V
+		ok    bool
+	)
+
+	value, ok = m[key]
+	if !ok {
+		return fallback
+	}
+
+	return value
+}
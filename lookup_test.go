@@ -0,0 +1,93 @@
+package gostacode
+
+import (
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestLookupOrDefaultIntKey(t *testing.T) {
+	var m map[int]codes.Code = map[int]codes.Code{
+		http.StatusNotFound: codes.NotFound,
+	}
+
+	var testCases []struct {
+		Name        string
+		Key         int
+		Expectation codes.Code
+	} = []struct {
+		Name        string
+		Key         int
+		Expectation codes.Code
+	}{
+		{
+			Name:        "present",
+			Key:         http.StatusNotFound,
+			Expectation: codes.NotFound,
+		},
+		{
+			Name:        "missing",
+			Key:         http.StatusTeapot,
+			Expectation: codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = LookupOrDefault(m, testCases[i].Key, codes.Unknown)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestLookupOrDefaultGRPCCodeKey(t *testing.T) {
+	var m map[codes.Code]slog.Level = map[codes.Code]slog.Level{
+		codes.Internal: slog.LevelError,
+	}
+
+	var testCases []struct {
+		Name        string
+		Map         map[codes.Code]slog.Level
+		Key         codes.Code
+		Expectation slog.Level
+	} = []struct {
+		Name        string
+		Map         map[codes.Code]slog.Level
+		Key         codes.Code
+		Expectation slog.Level
+	}{
+		{
+			Name:        "present",
+			Map:         m,
+			Key:         codes.Internal,
+			Expectation: slog.LevelError,
+		},
+		{
+			Name:        "missing",
+			Map:         m,
+			Key:         codes.NotFound,
+			Expectation: slog.LevelWarn,
+		},
+		{
+			Name:        "nil map",
+			Map:         nil,
+			Key:         codes.Internal,
+			Expectation: slog.LevelWarn,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual slog.Level = LookupOrDefault(testCases[i].Map, testCases[i].Key, slog.LevelWarn)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
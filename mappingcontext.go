@@ -0,0 +1,38 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// MappingContext carries what is known about the exchange a gRPC code came from.
+type MappingContext struct {
+	// IsGateway is set when the code was produced by an upstream behind a gateway.
+	IsGateway bool
+	// IsClientCancel is set when the client aborted the request.
+	IsClientCancel bool
+	// Method is the HTTP method of the request, used to pick the success status.
+	Method string
+}
+
+// HTTPStatusForGRPCCodeContext returns the HTTP status code for grpcCode refined by ctx:
+// Canceled with IsClientCancel maps to 499 Client Closed Request,
+// DeadlineExceeded with IsClientCancel maps to 408 Request Timeout,
+// Unavailable with IsGateway maps to 502 Bad Gateway,
+// and OK with a Method maps to SuccessStatusForMethod(Method).
+// Any other combination maps like HTTPStatusCodeFromGRPCCode.
+func HTTPStatusForGRPCCodeContext(grpcCode codes.Code, ctx MappingContext) int {
+	switch {
+	case grpcCode == codes.Canceled && ctx.IsClientCancel:
+		return StatusClientClosedRequest
+	case grpcCode == codes.DeadlineExceeded && ctx.IsClientCancel:
+		return http.StatusRequestTimeout
+	case grpcCode == codes.Unavailable && ctx.IsGateway:
+		return http.StatusBadGateway
+	case grpcCode == codes.OK && ctx.Method != "":
+		return SuccessStatusForMethod(ctx.Method)
+	default:
+		return HTTPStatusCodeFromGRPCCode(grpcCode)
+	}
+}
@@ -0,0 +1,81 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatusForGRPCCodeContext(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Context     MappingContext
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Context     MappingContext
+		Expectation int
+	}{
+		{
+			Name:        "canceled",
+			GRPCCode:    codes.Canceled,
+			Context:     MappingContext{},
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        "canceled by client",
+			GRPCCode:    codes.Canceled,
+			Context:     MappingContext{IsClientCancel: true},
+			Expectation: 499,
+		},
+		{
+			Name:        "deadline exceeded",
+			GRPCCode:    codes.DeadlineExceeded,
+			Context:     MappingContext{},
+			Expectation: http.StatusGatewayTimeout,
+		},
+		{
+			Name:        "deadline exceeded by client",
+			GRPCCode:    codes.DeadlineExceeded,
+			Context:     MappingContext{IsClientCancel: true},
+			Expectation: http.StatusRequestTimeout,
+		},
+		{
+			Name:        "unavailable",
+			GRPCCode:    codes.Unavailable,
+			Context:     MappingContext{},
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        "unavailable behind gateway",
+			GRPCCode:    codes.Unavailable,
+			Context:     MappingContext{IsGateway: true},
+			Expectation: http.StatusBadGateway,
+		},
+		{
+			Name:        "ok without method",
+			GRPCCode:    codes.OK,
+			Context:     MappingContext{},
+			Expectation: http.StatusOK,
+		},
+		{
+			Name:        "ok with post method",
+			GRPCCode:    codes.OK,
+			Context:     MappingContext{Method: http.MethodPost},
+			Expectation: http.StatusCreated,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusForGRPCCodeContext(testCases[i].GRPCCode, testCases[i].Context)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	maskedGRPCCodes map[codes.Code]bool = map[codes.Code]bool{
+		codes.Unknown:  true,
+		codes.Internal: true,
+		codes.DataLoss: true,
+	}
+)
+
+// ShouldMaskFromUser reports whether the details of an error with grpcCode should be hidden from end users
+// behind a generic message, which is the case for Unknown, Internal and DataLoss.
+func ShouldMaskFromUser(grpcCode codes.Code) bool {
+	return maskedGRPCCodes[grpcCode]
+}
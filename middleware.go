@@ -0,0 +1,184 @@
+package gostacode
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+// mappingDebugHeader is the response header WithDebugHeaders attaches the applied mapping to.
+const mappingDebugHeader string = "X-Gostacode-Mapping"
+
+// middlewareConfig holds the settings of StatusRewriteMiddleware.
+type middlewareConfig struct {
+	debugHeaders bool
+}
+
+// MiddlewareOption configures StatusRewriteMiddleware.
+type MiddlewareOption func(*middlewareConfig)
+
+// WithDebugHeaders makes StatusRewriteMiddleware describe the mapping it applied
+// in an X-Gostacode-Mapping header, such as "grpc=NotFound;http=404;rule=exact".
+// The rule is "exact" when the gRPC code has an entry in the mapping table and "fallback" otherwise.
+// It is off by default, since the header exposes internals that production responses should not leak.
+func WithDebugHeaders() MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.debugHeaders = true
+	}
+}
+
+type statusRewriteResponseWriter struct {
+	http.ResponseWriter
+	cfg            middlewareConfig
+	httpStatusCode int
+	body           bytes.Buffer
+	// committed reports whether the status and the buffered body were sent,
+	// after which writes go straight to the wrapped ResponseWriter.
+	committed bool
+}
+
+func (w *statusRewriteResponseWriter) WriteHeader(httpStatusCode int) {
+	if w.httpStatusCode == 0 {
+		w.httpStatusCode = httpStatusCode
+	}
+}
+
+func (w *statusRewriteResponseWriter) Write(b []byte) (int, error) {
+	if w.committed {
+		return w.ResponseWriter.Write(b)
+	}
+
+	return w.body.Write(b)
+}
+
+// FlushError commits the response and flushes the wrapped ResponseWriter,
+// so the status can no longer be rewritten and later writes are streamed.
+func (w *statusRewriteResponseWriter) FlushError() error {
+	var err error = w.commit()
+	if err != nil {
+		return err
+	}
+
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Flush is FlushError for callers using http.Flusher, which cannot report errors.
+func (w *statusRewriteResponseWriter) Flush() {
+	w.FlushError()
+}
+
+// Unwrap returns the wrapped ResponseWriter for http.ResponseController.
+func (w *statusRewriteResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// commit writes the status, rewritten from the grpc-status set so far, and the buffered body.
+// It does nothing once the response is committed.
+func (w *statusRewriteResponseWriter) commit() error {
+	if w.committed {
+		return nil
+	}
+
+	var (
+		grpcCode codes.Code
+		ok       bool
+		mapped   bool
+		err      error
+	)
+
+	grpcCode, ok = grpcStatusFromHeader(w.Header())
+
+	switch {
+	case !ok:
+	case w.httpStatusCode == 0, w.httpStatusCode >= 200 && w.httpStatusCode <= 299 && grpcCode != codes.OK:
+		w.httpStatusCode, mapped = HTTPStatusCodeFromGRPCCodeOK(grpcCode)
+
+		if w.cfg.debugHeaders {
+			w.Header().Set(mappingDebugHeader, mappingDebugHeaderValue(grpcCode, w.httpStatusCode, mapped))
+		}
+	}
+
+	if w.httpStatusCode == 0 {
+		w.httpStatusCode = http.StatusOK
+	}
+
+	w.committed = true
+	w.ResponseWriter.WriteHeader(w.httpStatusCode)
+
+	_, err = w.ResponseWriter.Write(w.body.Bytes())
+	w.body.Reset()
+
+	return err
+}
+
+// mappingDebugHeaderValue returns the X-Gostacode-Mapping value describing the mapping of grpcCode to httpStatusCode.
+func mappingDebugHeaderValue(grpcCode codes.Code, httpStatusCode int, mapped bool) string {
+	var rule string = "fallback"
+
+	if mapped {
+		rule = "exact"
+	}
+
+	return "grpc=" + grpcCode.String() + ";http=" + strconv.Itoa(httpStatusCode) + ";rule=" + rule
+}
+
+// grpcStatusFromHeader returns the grpc-status value of header, set either as a header
+// or as a trailer announced with the http.TrailerPrefix convention.
+func grpcStatusFromHeader(header http.Header) (codes.Code, bool) {
+	var (
+		value string
+		n     uint64
+		err   error
+	)
+
+	value = header.Get("Grpc-Status")
+	if value == "" {
+		value = header.Get(http.TrailerPrefix + "Grpc-Status")
+	}
+
+	if value == "" {
+		return codes.Unknown, false
+	}
+
+	n, err = strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return codes.Unknown, false
+	}
+
+	return codes.Code(n), true
+}
+
+// StatusRewriteMiddleware sets the HTTP status of the response to the one mapped from its final grpc-status,
+// which gRPC-Web handlers only know after the body is produced.
+// The response body is buffered until next returns so the final value can be read.
+// The status written by next is kept when there is no valid grpc-status,
+// when it is not a 2xx status, or when it is a 2xx status and grpc-status is OK.
+// Otherwise, such as when next wrote 200 up front and the call then failed, it is replaced by the mapped status.
+//
+// The rewrite is meant for unary responses. A handler that flushes, such as a server-streaming one,
+// commits the status from the grpc-status set at its first flush, and the rest of the body is streamed unbuffered.
+// An error writing the buffered body after next returns is logged, since there is no caller left to return it to.
+func StatusRewriteMiddleware(next http.Handler, opts ...MiddlewareOption) http.Handler {
+	var cfg middlewareConfig
+
+	for i := range opts {
+		opts[i](&cfg)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			rw  *statusRewriteResponseWriter = &statusRewriteResponseWriter{ResponseWriter: w, cfg: cfg}
+			err error
+		)
+
+		next.ServeHTTP(rw, r)
+
+		err = rw.commit()
+		if err != nil {
+			slog.Log(r.Context(), slog.LevelWarn, "gostacode: writing the buffered response failed", "error", err.Error())
+		}
+	})
+}
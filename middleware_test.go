@@ -0,0 +1,220 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var errFailingWrite error = errors.New("failing write")
+
+type failingResponseWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w failingResponseWriter) Write(b []byte) (int, error) {
+	return 0, errFailingWrite
+}
+
+func TestStatusRewriteMiddleware(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Handler     http.HandlerFunc
+		Expectation int
+	} = []struct {
+		Name        string
+		Handler     http.HandlerFunc
+		Expectation int
+	}{
+		{
+			Name: "no status written",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("body"))
+				w.Header().Set("Grpc-Status", "5")
+			},
+			Expectation: http.StatusNotFound,
+		},
+		{
+			Name: "2xx written and trailer not ok",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Trailer", "Grpc-Status")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte("body"))
+				w.Header().Set("Grpc-Status", "14")
+			},
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name: "2xx written and trailer ok",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte("body"))
+				w.Header().Set(http.TrailerPrefix+"Grpc-Status", "0")
+			},
+			Expectation: http.StatusCreated,
+		},
+		{
+			Name: "non-2xx written",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				w.Header().Set("Grpc-Status", "5")
+			},
+			Expectation: http.StatusBadGateway,
+		},
+		{
+			Name: "no grpc-status",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+			Expectation: http.StatusAccepted,
+		},
+		{
+			Name: "invalid grpc-status",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Grpc-Status", "not-a-code")
+			},
+			Expectation: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+
+			StatusRewriteMiddleware(testCases[i].Handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			if testCases[i].Expectation != recorder.Code {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, recorder.Code)
+			}
+		})
+	}
+}
+
+func TestStatusRewriteMiddlewareWithDebugHeaders(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Opts        []MiddlewareOption
+		GRPCStatus  string
+		Expectation string
+	} = []struct {
+		Name        string
+		Opts        []MiddlewareOption
+		GRPCStatus  string
+		Expectation string
+	}{
+		{
+			Name:        "exact",
+			Opts:        []MiddlewareOption{WithDebugHeaders()},
+			GRPCStatus:  "5",
+			Expectation: "grpc=NotFound;http=404;rule=exact",
+		},
+		{
+			Name:        "fallback",
+			Opts:        []MiddlewareOption{WithDebugHeaders()},
+			GRPCStatus:  "99",
+			Expectation: "grpc=Code(99);http=500;rule=fallback",
+		},
+		{
+			Name:        "off by default",
+			Opts:        nil,
+			GRPCStatus:  "5",
+			Expectation: "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+				handler  http.HandlerFunc           = func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Grpc-Status", testCases[i].GRPCStatus)
+				}
+			)
+
+			StatusRewriteMiddleware(handler, testCases[i].Opts...).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			var actual string = recorder.Header().Get("X-Gostacode-Mapping")
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestStatusRewriteMiddlewareBody(t *testing.T) {
+	var (
+		recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+		handler  http.HandlerFunc           = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("hello "))
+			w.Write([]byte("world"))
+			w.Header().Set("Grpc-Status", "0")
+		}
+	)
+
+	StatusRewriteMiddleware(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	if "hello world" != recorder.Body.String() {
+		t.Errorf("expectation is %q, got %q", "hello world", recorder.Body.String())
+	}
+}
+
+func TestStatusRewriteMiddlewareStreaming(t *testing.T) {
+	var (
+		recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+		handler  http.HandlerFunc           = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("first "))
+
+			var err error = http.NewResponseController(w).Flush()
+			if err != nil {
+				t.Errorf("expectation error is nil, got %v", err)
+			}
+
+			if !recorder.Flushed || "first " != recorder.Body.String() {
+				t.Errorf("expectation is flushed %q, got flushed %t %q", "first ", recorder.Flushed, recorder.Body.String())
+			}
+
+			w.Write([]byte("second"))
+			w.Header().Set("Grpc-Status", "14")
+		}
+	)
+
+	StatusRewriteMiddleware(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	if http.StatusOK != recorder.Code {
+		t.Errorf("expectation is %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	if "first second" != recorder.Body.String() {
+		t.Errorf("expectation is %q, got %q", "first second", recorder.Body.String())
+	}
+}
+
+func TestStatusRewriteResponseWriterUnwrap(t *testing.T) {
+	var (
+		recorder *httptest.ResponseRecorder   = httptest.NewRecorder()
+		rw       *statusRewriteResponseWriter = &statusRewriteResponseWriter{ResponseWriter: recorder}
+	)
+
+	if http.ResponseWriter(recorder) != rw.Unwrap() {
+		t.Errorf("expectation is %p, got %p", recorder, rw.Unwrap())
+	}
+}
+
+func TestStatusRewriteMiddlewareFlushError(t *testing.T) {
+	var (
+		writer  failingResponseWriter = failingResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+		handler http.HandlerFunc      = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("body"))
+
+			var err error = http.NewResponseController(w).Flush()
+
+			if !errors.Is(err, errFailingWrite) {
+				t.Errorf("expectation error is %v, got %v", errFailingWrite, err)
+			}
+		}
+	)
+
+	StatusRewriteMiddleware(handler).ServeHTTP(writer, httptest.NewRequest(http.MethodPost, "/", nil))
+}
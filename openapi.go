@@ -0,0 +1,29 @@
+package gostacode
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+// OpenAPIResponse is an OpenAPI response object.
+type OpenAPIResponse struct {
+	Description string `json:"description"`
+}
+
+// OpenAPIResponsesFromGRPCCodes returns an OpenAPI responses object keyed by the HTTP status codes
+// mapped from grpcCodes. gRPC codes sharing an HTTP status code share one entry.
+func OpenAPIResponsesFromGRPCCodes(grpcCodes []codes.Code) map[string]OpenAPIResponse {
+	var responses map[string]OpenAPIResponse = make(map[string]OpenAPIResponse, len(grpcCodes))
+
+	for i := range grpcCodes {
+		var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCodes[i])
+
+		responses[strconv.Itoa(httpStatusCode)] = OpenAPIResponse{
+			Description: http.StatusText(httpStatusCode),
+		}
+	}
+
+	return responses
+}
@@ -0,0 +1,57 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestOpenAPIResponsesFromGRPCCodes(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCodes   []codes.Code
+		Expectation map[string]OpenAPIResponse
+	} = []struct {
+		Name        string
+		GRPCCodes   []codes.Code
+		Expectation map[string]OpenAPIResponse
+	}{
+		{
+			Name:      "not found and invalid argument",
+			GRPCCodes: []codes.Code{codes.NotFound, codes.InvalidArgument},
+			Expectation: map[string]OpenAPIResponse{
+				"404": {Description: http.StatusText(http.StatusNotFound)},
+				"400": {Description: http.StatusText(http.StatusBadRequest)},
+			},
+		},
+		{
+			Name:      "shared status",
+			GRPCCodes: []codes.Code{codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange},
+			Expectation: map[string]OpenAPIResponse{
+				"400": {Description: http.StatusText(http.StatusBadRequest)},
+			},
+		},
+		{
+			Name:        "empty",
+			GRPCCodes:   nil,
+			Expectation: map[string]OpenAPIResponse{},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual map[string]OpenAPIResponse = OpenAPIResponsesFromGRPCCodes(testCases[i].GRPCCodes)
+
+			if len(testCases[i].Expectation) != len(actual) {
+				t.Errorf("expectation is %d entries, got %d", len(testCases[i].Expectation), len(actual))
+			}
+
+			for httpStatusCode, expectation := range testCases[i].Expectation {
+				if expectation != actual[httpStatusCode] {
+					t.Errorf("expectation is %+v, got %+v", expectation, actual[httpStatusCode])
+				}
+			}
+		})
+	}
+}
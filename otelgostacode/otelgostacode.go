@@ -0,0 +1,42 @@
+// Package otelgostacode records gostacode conversions on OpenTelemetry spans.
+// It lives apart from gostacode so the core package does not depend on OpenTelemetry.
+package otelgostacode
+
+import (
+	"context"
+
+	"github.com/fikri240794/gostacode"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+// HTTPStatusCodeFromGRPCCodeWithSpan returns gostacode.HTTPStatusCodeFromGRPCCode(grpcCode) and,
+// when ctx carries a recording span, sets the rpc.grpc.status_code and http.status_code attributes on it.
+func HTTPStatusCodeFromGRPCCodeWithSpan(ctx context.Context, grpcCode codes.Code) int {
+	var (
+		httpStatusCode int        = gostacode.HTTPStatusCodeFromGRPCCode(grpcCode)
+		span           trace.Span = trace.SpanFromContext(ctx)
+	)
+
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.Int("rpc.grpc.status_code", int(grpcCode)),
+			attribute.Int("http.status_code", httpStatusCode),
+		)
+	}
+
+	return httpStatusCode
+}
+
+// OTelSpanStatusFromGRPCCode returns the span status for a call that ended with grpcCode, ready for span.SetStatus:
+// Ok for codes.OK, and Error described by the code name for any other code. It never returns Unset.
+// Unlike gostacode.SpanStatusFromGRPCCode, client errors are errors too.
+func OTelSpanStatusFromGRPCCode(grpcCode codes.Code) (otelcodes.Code, string) {
+	if grpcCode == codes.OK {
+		return otelcodes.Ok, ""
+	}
+
+	return otelcodes.Error, grpcCode.String()
+}
@@ -0,0 +1,131 @@
+package otelgostacode
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatusCodeFromGRPCCodeWithSpan(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+	}{
+		{
+			Name:                      codes.NotFound.String(),
+			GRPCCode:                  codes.NotFound,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:                      codes.Unavailable.String(),
+			GRPCCode:                  codes.Unavailable,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				recorder *tracetest.SpanRecorder  = tracetest.NewSpanRecorder()
+				provider *sdktrace.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+				ctx      context.Context
+				span     trace.Span
+				actual   int
+			)
+
+			ctx, span = provider.Tracer("test").Start(context.Background(), "convert")
+			actual = HTTPStatusCodeFromGRPCCodeWithSpan(ctx, testCases[i].GRPCCode)
+			span.End()
+
+			if testCases[i].ExpectationHTTPStatusCode != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actual)
+			}
+
+			var attributes map[attribute.Key]attribute.Value = map[attribute.Key]attribute.Value{}
+
+			for _, keyValue := range recorder.Ended()[0].Attributes() {
+				attributes[keyValue.Key] = keyValue.Value
+			}
+
+			if int64(testCases[i].GRPCCode) != attributes["rpc.grpc.status_code"].AsInt64() {
+				t.Errorf("expectation is %d, got %d", testCases[i].GRPCCode, attributes["rpc.grpc.status_code"].AsInt64())
+			}
+
+			if int64(testCases[i].ExpectationHTTPStatusCode) != attributes["http.status_code"].AsInt64() {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, attributes["http.status_code"].AsInt64())
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodeFromGRPCCodeWithSpanWithoutSpan(t *testing.T) {
+	var actual int = HTTPStatusCodeFromGRPCCodeWithSpan(context.Background(), codes.NotFound)
+
+	if actual != http.StatusNotFound {
+		t.Errorf("expectation is %d, got %d", http.StatusNotFound, actual)
+	}
+}
+
+func TestOTelSpanStatusFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name                   string
+		GRPCCode               codes.Code
+		ExpectationCode        otelcodes.Code
+		ExpectationDescription string
+	} = []struct {
+		Name                   string
+		GRPCCode               codes.Code
+		ExpectationCode        otelcodes.Code
+		ExpectationDescription string
+	}{
+		{
+			Name:                   codes.OK.String(),
+			GRPCCode:               codes.OK,
+			ExpectationCode:        otelcodes.Ok,
+			ExpectationDescription: "",
+		},
+		{
+			Name:                   codes.Internal.String(),
+			GRPCCode:               codes.Internal,
+			ExpectationCode:        otelcodes.Error,
+			ExpectationDescription: "Internal",
+		},
+		{
+			Name:                   codes.NotFound.String(),
+			GRPCCode:               codes.NotFound,
+			ExpectationCode:        otelcodes.Error,
+			ExpectationDescription: "NotFound",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCode        otelcodes.Code
+				actualDescription string
+			)
+
+			actualCode, actualDescription = OTelSpanStatusFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationCode != actualCode {
+				t.Errorf("expectation is %v, got %v", testCases[i].ExpectationCode, actualCode)
+			}
+
+			if testCases[i].ExpectationDescription != actualDescription {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationDescription, actualDescription)
+			}
+		})
+	}
+}
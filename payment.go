@@ -0,0 +1,21 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// PaymentStatusFromGRPCCode returns the HTTP status code for grpcCode in a payment flow,
+// together with a Stripe-style decline hint: (402, "card_declined") for FailedPrecondition,
+// (429, "rate_limited") for ResourceExhausted, and the regular mapping with an empty hint otherwise.
+func PaymentStatusFromGRPCCode(grpcCode codes.Code) (int, string) {
+	switch grpcCode {
+	case codes.FailedPrecondition:
+		return http.StatusPaymentRequired, "card_declined"
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests, "rate_limited"
+	default:
+		return HTTPStatusCodeFromGRPCCode(grpcCode), ""
+	}
+}
@@ -0,0 +1,60 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestPaymentStatusFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationDeclineHint    string
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationDeclineHint    string
+	}{
+		{
+			Name:                      codes.FailedPrecondition.String(),
+			GRPCCode:                  codes.FailedPrecondition,
+			ExpectationHTTPStatusCode: http.StatusPaymentRequired,
+			ExpectationDeclineHint:    "card_declined",
+		},
+		{
+			Name:                      codes.ResourceExhausted.String(),
+			GRPCCode:                  codes.ResourceExhausted,
+			ExpectationHTTPStatusCode: http.StatusTooManyRequests,
+			ExpectationDeclineHint:    "rate_limited",
+		},
+		{
+			Name:                      codes.NotFound.String(),
+			GRPCCode:                  codes.NotFound,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationDeclineHint:    "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualDeclineHint    string
+			)
+
+			actualHTTPStatusCode, actualDeclineHint = PaymentStatusFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationHTTPStatusCode != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actualHTTPStatusCode)
+			}
+
+			if testCases[i].ExpectationDeclineHint != actualDeclineHint {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationDeclineHint, actualDeclineHint)
+			}
+		})
+	}
+}
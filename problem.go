@@ -0,0 +1,40 @@
+package gostacode
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ProblemDetail is an RFC 7807 problem detail, served as application/problem+json.
+type ProblemDetail struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type problemDetailJSON ProblemDetail
+
+// ProblemFromGRPCCode returns a ProblemDetail whose status is mapped from grpcCode,
+// whose title is the status text of that status and whose type is "about:blank".
+func ProblemFromGRPCCode(grpcCode codes.Code, detail string) ProblemDetail {
+	var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCode)
+
+	return ProblemDetail{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpStatusCode),
+		Status: httpStatusCode,
+		Detail: detail,
+	}
+}
+
+// MarshalJSON encodes p, writing "about:blank" as the type when Type is empty.
+func (p ProblemDetail) MarshalJSON() ([]byte, error) {
+	if p.Type == "" {
+		p.Type = "about:blank"
+	}
+
+	return json.Marshal(problemDetailJSON(p))
+}
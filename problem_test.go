@@ -0,0 +1,89 @@
+package gostacode
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestProblemFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name            string
+		GRPCCode        codes.Code
+		Detail          string
+		ExpectationJSON string
+	} = []struct {
+		Name            string
+		GRPCCode        codes.Code
+		Detail          string
+		ExpectationJSON string
+	}{
+		{
+			Name:            codes.NotFound.String(),
+			GRPCCode:        codes.NotFound,
+			Detail:          "user 42 not found",
+			ExpectationJSON: `{"type":"about:blank","title":"Not Found","status":404,"detail":"user 42 not found"}`,
+		},
+		{
+			Name:            "without detail",
+			GRPCCode:        codes.Unavailable,
+			Detail:          "",
+			ExpectationJSON: `{"type":"about:blank","title":"Service Unavailable","status":503}`,
+		},
+		{
+			Name:            "unmapped",
+			GRPCCode:        codes.Code(99),
+			Detail:          "",
+			ExpectationJSON: `{"type":"about:blank","title":"Internal Server Error","status":500}`,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual     ProblemDetail = ProblemFromGRPCCode(testCases[i].GRPCCode, testCases[i].Detail)
+				actualJSON []byte
+				err        error
+			)
+
+			if HTTPStatusCodeFromGRPCCode(testCases[i].GRPCCode) != actual.Status {
+				t.Errorf("expectation status is %d, got %d", HTTPStatusCodeFromGRPCCode(testCases[i].GRPCCode), actual.Status)
+			}
+
+			if http.StatusText(actual.Status) != actual.Title {
+				t.Errorf("expectation title is %q, got %q", http.StatusText(actual.Status), actual.Title)
+			}
+
+			actualJSON, err = json.Marshal(actual)
+			if err != nil {
+				t.Fatalf("expectation is nil error, got %v", err)
+			}
+
+			if testCases[i].ExpectationJSON != string(actualJSON) {
+				t.Errorf("expectation is %s, got %s", testCases[i].ExpectationJSON, actualJSON)
+			}
+		})
+	}
+}
+
+func TestProblemDetailMarshalJSONDefaultType(t *testing.T) {
+	var (
+		expectation string = `{"type":"about:blank","title":"Conflict","status":409}`
+		actual      []byte
+		err         error
+	)
+
+	actual, err = json.Marshal(ProblemDetail{
+		Title:  http.StatusText(http.StatusConflict),
+		Status: http.StatusConflict,
+	})
+	if err != nil {
+		t.Fatalf("expectation is nil error, got %v", err)
+	}
+
+	if expectation != string(actual) {
+		t.Errorf("expectation is %s, got %s", expectation, actual)
+	}
+}
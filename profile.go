@@ -0,0 +1,69 @@
+package gostacode
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+const profileHeader string = "X-Gostacode-Profile"
+
+var (
+	// gatewayConverter matches grpc-gateway's runtime.HTTPStatusFromCode.
+	gatewayConverter *Converter = NewConverter(WithClientClosedRequest())
+	nginxConverter   *Converter = NewConverter(WithClientClosedRequest())
+
+	profileConverters map[string]*Converter = map[string]*Converter{
+		"default":      defaultConverter,
+		"grpc-gateway": gatewayConverter,
+		"nginx":        nginxConverter,
+	}
+)
+
+// HTTPStatusCodeFromGRPCCodeGateway returns the HTTP status code that grpc-gateway's runtime.HTTPStatusFromCode
+// returns for grpcCode. It differs from HTTPStatusCodeFromGRPCCode only for Canceled,
+// which maps to 499 Client Closed Request instead of 500 Internal Server Error.
+// FailedPrecondition, Aborted and OutOfRange map to 400, 409 and 400 in both.
+func HTTPStatusCodeFromGRPCCodeGateway(grpcCode codes.Code) int {
+	return gatewayConverter.HTTPStatusCode(grpcCode)
+}
+
+// ConverterForProfile returns a new Converter holding the mappings of a named mapping profile.
+// Overrides on the returned Converter never affect the profile or the package-level functions.
+//
+// Supported profiles:
+//   - "default": the standard mappings used by the package-level functions.
+//   - "grpc-gateway": the mappings of grpc-gateway, where Canceled maps to 499 Client Closed Request.
+//   - "nginx": the standard mappings with Canceled mapped to 499 Client Closed Request, as logged by nginx.
+//
+// It returns ErrUnknownProfile for any other profile.
+func ConverterForProfile(profile string) (*Converter, error) {
+	var (
+		c  *Converter
+		ok bool
+	)
+
+	c, ok = profileConverters[profile]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProfile, profile)
+	}
+
+	return c.clone(), nil
+}
+
+// ConverterFromAcceptProfile returns a new Converter for the profile named by the X-Gostacode-Profile header,
+// or for the "default" profile when the header is absent or names an unknown profile.
+func ConverterFromAcceptProfile(header http.Header) *Converter {
+	var (
+		c   *Converter
+		err error
+	)
+
+	c, err = ConverterForProfile(header.Get(profileHeader))
+	if err != nil {
+		return defaultConverter.clone()
+	}
+
+	return c
+}
@@ -0,0 +1,257 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestConverterForProfile(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		Profile                   string
+		ExpectationErr            error
+		ExpectationHTTPStatusCode int
+	} = []struct {
+		Name                      string
+		Profile                   string
+		ExpectationErr            error
+		ExpectationHTTPStatusCode int
+	}{
+		{
+			Name:                      "default",
+			Profile:                   "default",
+			ExpectationErr:            nil,
+			ExpectationHTTPStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name:                      "grpc-gateway",
+			Profile:                   "grpc-gateway",
+			ExpectationErr:            nil,
+			ExpectationHTTPStatusCode: 499,
+		},
+		{
+			Name:                      "unknown",
+			Profile:                   "unknown",
+			ExpectationErr:            ErrUnknownProfile,
+			ExpectationHTTPStatusCode: 0,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual *Converter
+				err    error
+			)
+
+			actual, err = ConverterForProfile(testCases[i].Profile)
+
+			if !errors.Is(err, testCases[i].ExpectationErr) {
+				t.Fatalf("expectation error is %v, got %v", testCases[i].ExpectationErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if testCases[i].ExpectationHTTPStatusCode != actual.HTTPStatusCode(codes.Canceled) {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actual.HTTPStatusCode(codes.Canceled))
+			}
+		})
+	}
+}
+
+func TestConverterFromAcceptProfile(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Header      http.Header
+		Expectation int
+	} = []struct {
+		Name        string
+		Header      http.Header
+		Expectation int
+	}{
+		{
+			Name:        "valid profile",
+			Header:      http.Header{"X-Gostacode-Profile": []string{"grpc-gateway"}},
+			Expectation: 499,
+		},
+		{
+			Name:        "missing profile",
+			Header:      http.Header{},
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        "unknown profile",
+			Header:      http.Header{"X-Gostacode-Profile": []string{"unknown"}},
+			Expectation: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = ConverterFromAcceptProfile(testCases[i].Header).HTTPStatusCode(codes.Canceled)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestConverterForProfileOverrideDoesNotLeak(t *testing.T) {
+	var profiles []string = []string{"default", "grpc-gateway", "nginx"}
+
+	for i := range profiles {
+		t.Run(profiles[i], func(t *testing.T) {
+			var (
+				c   *Converter
+				err error
+			)
+
+			c, err = ConverterForProfile(profiles[i])
+			if err != nil {
+				t.Fatalf("expectation error is nil, got %v", err)
+			}
+
+			c.SetGRPCToHTTP(codes.Canceled, http.StatusTeapot)
+			c.SetHTTPToGRPC(http.StatusNotFound, codes.Internal)
+
+			if http.StatusInternalServerError != HTTPStatusCodeFromGRPCCode(codes.Canceled) {
+				t.Errorf("expectation is %d, got %d", http.StatusInternalServerError, HTTPStatusCodeFromGRPCCode(codes.Canceled))
+			}
+
+			if codes.NotFound != GRPCCodeFromHTTPStatusCode(http.StatusNotFound) {
+				t.Errorf("expectation is %d, got %d", codes.NotFound, GRPCCodeFromHTTPStatusCode(http.StatusNotFound))
+			}
+
+			if 499 != HTTPStatusCodeFromGRPCCodeGateway(codes.Canceled) {
+				t.Errorf("expectation is %d, got %d", 499, HTTPStatusCodeFromGRPCCodeGateway(codes.Canceled))
+			}
+
+			if 499 != HTTPStatusCodeFromGRPCCodeNginx(codes.Canceled) {
+				t.Errorf("expectation is %d, got %d", 499, HTTPStatusCodeFromGRPCCodeNginx(codes.Canceled))
+			}
+
+			c, err = ConverterForProfile(profiles[i])
+			if err != nil {
+				t.Fatalf("expectation error is nil, got %v", err)
+			}
+
+			if codes.NotFound != c.GRPCCode(http.StatusNotFound) {
+				t.Errorf("expectation is %d, got %d", codes.NotFound, c.GRPCCode(http.StatusNotFound))
+			}
+		})
+	}
+}
+
+func TestHTTPStatusCodeFromGRPCCodeGateway(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation int
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: http.StatusOK,
+		},
+		{
+			Name:        codes.Canceled.String(),
+			GRPCCode:    codes.Canceled,
+			Expectation: 499,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: http.StatusBadRequest,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: http.StatusGatewayTimeout,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: http.StatusNotFound,
+		},
+		{
+			Name:        codes.AlreadyExists.String(),
+			GRPCCode:    codes.AlreadyExists,
+			Expectation: http.StatusConflict,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: http.StatusForbidden,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: http.StatusTooManyRequests,
+		},
+		{
+			Name:        codes.FailedPrecondition.String(),
+			GRPCCode:    codes.FailedPrecondition,
+			Expectation: http.StatusBadRequest,
+		},
+		{
+			Name:        codes.Aborted.String(),
+			GRPCCode:    codes.Aborted,
+			Expectation: http.StatusConflict,
+		},
+		{
+			Name:        codes.OutOfRange.String(),
+			GRPCCode:    codes.OutOfRange,
+			Expectation: http.StatusBadRequest,
+		},
+		{
+			Name:        codes.Unimplemented.String(),
+			GRPCCode:    codes.Unimplemented,
+			Expectation: http.StatusNotImplemented,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: http.StatusServiceUnavailable,
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: http.StatusInternalServerError,
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: http.StatusUnauthorized,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = HTTPStatusCodeFromGRPCCodeGateway(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
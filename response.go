@@ -0,0 +1,56 @@
+package gostacode
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// HTTPResponseFromGRPCCode returns the HTTP status code mapped from grpcCode together with its status text.
+func HTTPResponseFromGRPCCode(grpcCode codes.Code) (int, string) {
+	var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCode)
+
+	return httpStatusCode, http.StatusText(httpStatusCode)
+}
+
+// WriteGRPCCodeError replies to the request with the HTTP status mapped from grpcCode
+// and message as a plain text body, the same way http.Error does.
+// An empty message is replaced by the status text of the mapped HTTP status code.
+func WriteGRPCCodeError(w http.ResponseWriter, grpcCode codes.Code, message string) {
+	var httpStatusCode int = HTTPStatusCodeFromGRPCCode(grpcCode)
+
+	if message == "" {
+		message = http.StatusText(httpStatusCode)
+	}
+
+	http.Error(w, message, httpStatusCode)
+}
+
+// WriteMaskedGRPCError logs internalMessage through the default slog logger at the level of grpcCode
+// and replies like WriteGRPCCodeError. The body is internalMessage for codes that are safe to detail
+// and the generic status text for codes that ShouldMaskFromUser reports as masked.
+func WriteMaskedGRPCError(w http.ResponseWriter, grpcCode codes.Code, internalMessage string) {
+	var message string = internalMessage
+
+	slog.Log(context.Background(), LogLevelFromGRPCCode(grpcCode), internalMessage, "grpc_code", grpcCode.String())
+
+	if ShouldMaskFromUser(grpcCode) {
+		message = ""
+	}
+
+	WriteGRPCCodeError(w, grpcCode, message)
+}
+
+// ApplyGRPCCodeToResponse sets the StatusCode and Status of resp to the HTTP status mapped from grpcCode.
+// It does nothing when resp is nil.
+func ApplyGRPCCodeToResponse(resp *http.Response, grpcCode codes.Code) {
+	if resp == nil {
+		return
+	}
+
+	resp.StatusCode = HTTPStatusCodeFromGRPCCode(grpcCode)
+	resp.Status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+}
@@ -0,0 +1,207 @@
+package gostacode
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestWriteGRPCCodeError(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		Message                   string
+		ExpectationHTTPStatusCode int
+		ExpectationBody           string
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		Message                   string
+		ExpectationHTTPStatusCode int
+		ExpectationBody           string
+	}{
+		{
+			Name:                      "not found with message",
+			GRPCCode:                  codes.NotFound,
+			Message:                   "user not found",
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationBody:           "user not found\n",
+		},
+		{
+			Name:                      "not found without message",
+			GRPCCode:                  codes.NotFound,
+			Message:                   "",
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationBody:           http.StatusText(http.StatusNotFound) + "\n",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+
+			WriteGRPCCodeError(recorder, testCases[i].GRPCCode, testCases[i].Message)
+
+			if testCases[i].ExpectationHTTPStatusCode != recorder.Code {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, recorder.Code)
+			}
+
+			if testCases[i].ExpectationBody != recorder.Body.String() {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestWriteMaskedGRPCError(t *testing.T) {
+	var (
+		logs     *bytes.Buffer = &bytes.Buffer{}
+		previous *slog.Logger  = slog.Default()
+	)
+
+	slog.SetDefault(slog.New(slog.NewTextHandler(logs, nil)))
+	defer slog.SetDefault(previous)
+
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		InternalMessage           string
+		ExpectationHTTPStatusCode int
+		ExpectationBody           string
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		InternalMessage           string
+		ExpectationHTTPStatusCode int
+		ExpectationBody           string
+	}{
+		{
+			Name:                      codes.Internal.String(),
+			GRPCCode:                  codes.Internal,
+			InternalMessage:           "pq: connection refused",
+			ExpectationHTTPStatusCode: http.StatusInternalServerError,
+			ExpectationBody:           http.StatusText(http.StatusInternalServerError) + "\n",
+		},
+		{
+			Name:                      codes.InvalidArgument.String(),
+			GRPCCode:                  codes.InvalidArgument,
+			InternalMessage:           "email is malformed",
+			ExpectationHTTPStatusCode: http.StatusBadRequest,
+			ExpectationBody:           "email is malformed\n",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+
+			logs.Reset()
+			WriteMaskedGRPCError(recorder, testCases[i].GRPCCode, testCases[i].InternalMessage)
+
+			if testCases[i].ExpectationHTTPStatusCode != recorder.Code {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, recorder.Code)
+			}
+
+			if testCases[i].ExpectationBody != recorder.Body.String() {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationBody, recorder.Body.String())
+			}
+
+			if !strings.Contains(logs.String(), testCases[i].InternalMessage) {
+				t.Errorf("expectation is log containing %q, got %q", testCases[i].InternalMessage, logs.String())
+			}
+		})
+	}
+}
+
+func TestApplyGRPCCodeToResponse(t *testing.T) {
+	var testCases []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		ExpectationStatusCode int
+		ExpectationStatus     string
+	} = []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		ExpectationStatusCode int
+		ExpectationStatus     string
+	}{
+		{
+			Name:                  codes.NotFound.String(),
+			GRPCCode:              codes.NotFound,
+			ExpectationStatusCode: http.StatusNotFound,
+			ExpectationStatus:     "404 Not Found",
+		},
+		{
+			Name:                  codes.Unavailable.String(),
+			GRPCCode:              codes.Unavailable,
+			ExpectationStatusCode: http.StatusServiceUnavailable,
+			ExpectationStatus:     "503 Service Unavailable",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var resp *http.Response = &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+			}
+
+			ApplyGRPCCodeToResponse(resp, testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationStatusCode != resp.StatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationStatusCode, resp.StatusCode)
+			}
+
+			if testCases[i].ExpectationStatus != resp.Status {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationStatus, resp.Status)
+			}
+		})
+	}
+
+	t.Run("nil response", func(t *testing.T) {
+		ApplyGRPCCodeToResponse(nil, codes.NotFound)
+	})
+}
+
+func TestHTTPResponseFromGRPCCode(t *testing.T) {
+	for grpcCode := codes.OK; grpcCode <= codes.Unauthenticated; grpcCode++ {
+		t.Run(grpcCode.String(), func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualText           string
+			)
+
+			actualHTTPStatusCode, actualText = HTTPResponseFromGRPCCode(grpcCode)
+
+			if HTTPStatusCodeFromGRPCCode(grpcCode) != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", HTTPStatusCodeFromGRPCCode(grpcCode), actualHTTPStatusCode)
+			}
+
+			if http.StatusText(actualHTTPStatusCode) != actualText {
+				t.Errorf("expectation is %q, got %q", http.StatusText(actualHTTPStatusCode), actualText)
+			}
+		})
+	}
+
+	t.Run("unmapped", func(t *testing.T) {
+		var (
+			actualHTTPStatusCode int
+			actualText           string
+		)
+
+		actualHTTPStatusCode, actualText = HTTPResponseFromGRPCCode(codes.Code(99))
+
+		if http.StatusInternalServerError != actualHTTPStatusCode {
+			t.Errorf("expectation is %d, got %d", http.StatusInternalServerError, actualHTTPStatusCode)
+		}
+
+		if "Internal Server Error" != actualText {
+			t.Errorf("expectation is %q, got %q", "Internal Server Error", actualText)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package gostacode
+
+import (
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	backoffBase time.Duration = time.Second
+	backoffMax  time.Duration = time.Minute
+)
+
+var (
+	retryableGRPCCodes map[codes.Code]bool = map[codes.Code]bool{
+		codes.Unavailable:       true,
+		codes.DeadlineExceeded:  true,
+		codes.ResourceExhausted: true,
+		codes.Aborted:           true,
+	}
+
+	terminalGRPCCodes []codes.Code = []codes.Code{
+		codes.InvalidArgument,
+		codes.NotFound,
+		codes.AlreadyExists,
+		codes.PermissionDenied,
+		codes.Unauthenticated,
+		codes.FailedPrecondition,
+		codes.OutOfRange,
+		codes.Unimplemented,
+	}
+
+	retryableHTTPStatusCodes map[int]bool = map[int]bool{
+		http.StatusTooManyRequests:    true,
+		http.StatusBadGateway:         true,
+		http.StatusServiceUnavailable: true,
+		http.StatusGatewayTimeout:     true,
+	}
+
+	// idempotentHTTPMethods follows RFC 9110 section 9.2.2.
+	idempotentHTTPMethods map[string]bool = map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+		http.MethodOptions: true,
+		http.MethodTrace:   true,
+	}
+)
+
+// IsRetryable reports whether a call that failed with grpcCode is worth retrying,
+// following the common gRPC retry guidance.
+func IsRetryable(grpcCode codes.Code) bool {
+	return retryableGRPCCodes[grpcCode]
+}
+
+// IsRetryableHTTPStatus reports whether a request that failed with httpStatusCode is worth retrying.
+func IsRetryableHTTPStatus(httpStatusCode int) bool {
+	return retryableHTTPStatusCodes[httpStatusCode]
+}
+
+// TerminalGRPCCodes returns the gRPC codes that mean a call failed permanently and must not be retried.
+// None of them is retryable. Codes such as Internal or Unknown are neither retryable nor terminal,
+// since whether they are permanent depends on the server.
+// The returned slice is a copy and can be modified freely.
+func TerminalGRPCCodes() []codes.Code {
+	var grpcCodes []codes.Code = make([]codes.Code, len(terminalGRPCCodes))
+
+	copy(grpcCodes, terminalGRPCCodes)
+
+	return grpcCodes
+}
+
+// IsIdempotentSafe reports whether a request sent with method that failed with grpcCode
+// can be retried safely, meaning the method is idempotent and the code is retryable.
+func IsIdempotentSafe(method string, grpcCode codes.Code) bool {
+	return idempotentHTTPMethods[method] && retryableGRPCCodes[grpcCode]
+}
+
+// HTTPStatusAndRetryable returns the HTTP status code mapped from grpcCode
+// together with whether grpcCode is retryable.
+func HTTPStatusAndRetryable(grpcCode codes.Code) (int, bool) {
+	return HTTPStatusCodeFromGRPCCode(grpcCode), retryableGRPCCodes[grpcCode]
+}
+
+// HTTPStatusWithBackoff returns the HTTP status code mapped from grpcCode and, when grpcCode is retryable,
+// the delay before the next attempt, growing from 1s as 1s * 2^attempt up to 1m.
+// The delay is zero for codes that are not retryable.
+func HTTPStatusWithBackoff(grpcCode codes.Code, attempt int) (int, time.Duration) {
+	var (
+		httpStatusCode int           = HTTPStatusCodeFromGRPCCode(grpcCode)
+		retryAfter     time.Duration = backoffBase
+	)
+
+	if !retryableGRPCCodes[grpcCode] {
+		return httpStatusCode, 0
+	}
+
+	for i := 0; i < attempt && retryAfter < backoffMax; i++ {
+		retryAfter *= 2
+	}
+
+	if retryAfter > backoffMax {
+		retryAfter = backoffMax
+	}
+
+	return httpStatusCode, retryAfter
+}
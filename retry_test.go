@@ -0,0 +1,347 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsIdempotentSafe(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Method      string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		Method      string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        http.MethodGet + " " + codes.Unavailable.String(),
+			Method:      http.MethodGet,
+			GRPCCode:    codes.Unavailable,
+			Expectation: true,
+		},
+		{
+			Name:        http.MethodPut + " " + codes.DeadlineExceeded.String(),
+			Method:      http.MethodPut,
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: true,
+		},
+		{
+			Name:        http.MethodPost + " " + codes.Unavailable.String(),
+			Method:      http.MethodPost,
+			GRPCCode:    codes.Unavailable,
+			Expectation: false,
+		},
+		{
+			Name:        http.MethodPatch + " " + codes.Unavailable.String(),
+			Method:      http.MethodPatch,
+			GRPCCode:    codes.Unavailable,
+			Expectation: false,
+		},
+		{
+			Name:        http.MethodGet + " " + codes.NotFound.String(),
+			Method:      http.MethodGet,
+			GRPCCode:    codes.NotFound,
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsIdempotentSafe(testCases[i].Method, testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusAndRetryable(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationRetryable      bool
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		ExpectationHTTPStatusCode int
+		ExpectationRetryable      bool
+	}{
+		{
+			Name:                      codes.Unavailable.String(),
+			GRPCCode:                  codes.Unavailable,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationRetryable:      true,
+		},
+		{
+			Name:                      codes.NotFound.String(),
+			GRPCCode:                  codes.NotFound,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationRetryable:      false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualRetryable      bool
+			)
+
+			actualHTTPStatusCode, actualRetryable = HTTPStatusAndRetryable(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationHTTPStatusCode != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actualHTTPStatusCode)
+			}
+
+			if testCases[i].ExpectationRetryable != actualRetryable {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationRetryable, actualRetryable)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusWithBackoff(t *testing.T) {
+	var testCases []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		Attempt                   int
+		ExpectationHTTPStatusCode int
+		ExpectationRetryAfter     time.Duration
+	} = []struct {
+		Name                      string
+		GRPCCode                  codes.Code
+		Attempt                   int
+		ExpectationHTTPStatusCode int
+		ExpectationRetryAfter     time.Duration
+	}{
+		{
+			Name:                      "unavailable attempt 0",
+			GRPCCode:                  codes.Unavailable,
+			Attempt:                   0,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationRetryAfter:     time.Second,
+		},
+		{
+			Name:                      "unavailable attempt 1",
+			GRPCCode:                  codes.Unavailable,
+			Attempt:                   1,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationRetryAfter:     2 * time.Second,
+		},
+		{
+			Name:                      "unavailable attempt 3",
+			GRPCCode:                  codes.Unavailable,
+			Attempt:                   3,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationRetryAfter:     8 * time.Second,
+		},
+		{
+			Name:                      "unavailable attempt 100",
+			GRPCCode:                  codes.Unavailable,
+			Attempt:                   100,
+			ExpectationHTTPStatusCode: http.StatusServiceUnavailable,
+			ExpectationRetryAfter:     time.Minute,
+		},
+		{
+			Name:                      "not found",
+			GRPCCode:                  codes.NotFound,
+			Attempt:                   3,
+			ExpectationHTTPStatusCode: http.StatusNotFound,
+			ExpectationRetryAfter:     0,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualHTTPStatusCode int
+				actualRetryAfter     time.Duration
+			)
+
+			actualHTTPStatusCode, actualRetryAfter = HTTPStatusWithBackoff(testCases[i].GRPCCode, testCases[i].Attempt)
+
+			if testCases[i].ExpectationHTTPStatusCode != actualHTTPStatusCode {
+				t.Errorf("expectation is %d, got %d", testCases[i].ExpectationHTTPStatusCode, actualHTTPStatusCode)
+			}
+
+			if testCases[i].ExpectationRetryAfter != actualRetryAfter {
+				t.Errorf("expectation is %s, got %s", testCases[i].ExpectationRetryAfter, actualRetryAfter)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: true,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: true,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Aborted.String(),
+			GRPCCode:    codes.Aborted,
+			Expectation: true,
+		},
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: false,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: false,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: false,
+		},
+		{
+			Name:        "unmapped",
+			GRPCCode:    codes.Code(99),
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsRetryable(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIsRetryableHTTPStatus(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	}{
+		{
+			Name:           http.StatusText(http.StatusTooManyRequests),
+			HTTPStatusCode: http.StatusTooManyRequests,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusBadGateway),
+			HTTPStatusCode: http.StatusBadGateway,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusServiceUnavailable),
+			HTTPStatusCode: http.StatusServiceUnavailable,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusGatewayTimeout),
+			HTTPStatusCode: http.StatusGatewayTimeout,
+			Expectation:    true,
+		},
+		{
+			Name:           http.StatusText(http.StatusOK),
+			HTTPStatusCode: http.StatusOK,
+			Expectation:    false,
+		},
+		{
+			Name:           http.StatusText(http.StatusInternalServerError),
+			HTTPStatusCode: http.StatusInternalServerError,
+			Expectation:    false,
+		},
+		{
+			Name:           http.StatusText(http.StatusTeapot),
+			HTTPStatusCode: http.StatusTeapot,
+			Expectation:    false,
+		},
+		{
+			Name:           "999",
+			HTTPStatusCode: 999,
+			Expectation:    false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsRetryableHTTPStatus(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestTerminalGRPCCodes(t *testing.T) {
+	var (
+		expectation []codes.Code = []codes.Code{
+			codes.InvalidArgument,
+			codes.NotFound,
+			codes.AlreadyExists,
+			codes.PermissionDenied,
+			codes.Unauthenticated,
+			codes.FailedPrecondition,
+			codes.OutOfRange,
+			codes.Unimplemented,
+		}
+		actual []codes.Code = TerminalGRPCCodes()
+	)
+
+	if len(expectation) != len(actual) {
+		t.Fatalf("expectation length is %d, got %d", len(expectation), len(actual))
+	}
+
+	for i := range expectation {
+		if expectation[i] != actual[i] {
+			t.Errorf("index %d: expectation is %s, got %s", i, expectation[i], actual[i])
+		}
+
+		if IsRetryable(actual[i]) {
+			t.Errorf("%s: expectation is not retryable", actual[i])
+		}
+
+		if actual[i] == codes.OK {
+			t.Errorf("expectation is not %s", actual[i])
+		}
+	}
+
+	actual[0] = codes.OK
+
+	if TerminalGRPCCodes()[0] != codes.InvalidArgument {
+		t.Errorf("expectation is a copy of the terminal codes")
+	}
+}
@@ -0,0 +1,119 @@
+package gostacode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryAfterNow returns the current time when resolving an HTTP-date Retry-After header.
+// Tests replace it to pin the clock.
+var retryAfterNow func() time.Time = time.Now
+
+func parseRetryAfter(retryAfterHeader string) (time.Duration, error) {
+	var (
+		value   string = strings.TrimSpace(retryAfterHeader)
+		seconds int
+		date    time.Time
+		now     time.Time
+		err     error
+	)
+
+	seconds, err = strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidRetryAfter, retryAfterHeader)
+		}
+
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	date, err = http.ParseTime(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRetryAfter, retryAfterHeader)
+	}
+
+	now = retryAfterNow()
+
+	if date.Before(now) {
+		return 0, nil
+	}
+
+	return date.Sub(now), nil
+}
+
+// GRPCStatusWithRetryAfter returns a status with grpcCode carrying a RetryInfo detail
+// whose delay is parsed from retryAfterHeader, in either the delay-seconds or the HTTP-date form.
+// It returns an error wrapping ErrInvalidRetryAfter when the header is malformed.
+func GRPCStatusWithRetryAfter(grpcCode codes.Code, retryAfterHeader string) (*status.Status, error) {
+	var (
+		retryAfter time.Duration
+		st         *status.Status
+		err        error
+	)
+
+	retryAfter, err = parseRetryAfter(retryAfterHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err = status.New(grpcCode, http.StatusText(HTTPStatusCodeFromGRPCCode(grpcCode))).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// HTTPResponseFromGRPCStatus synthesizes the HTTP response for st: its status is mapped from the code of st,
+// its body is an application/problem+json ProblemDetail whose detail is the message of st,
+// and it carries a Retry-After header, in whole seconds rounded up, when st has a RetryInfo detail.
+func HTTPResponseFromGRPCStatus(st *status.Status) *http.Response {
+	var (
+		problem ProblemDetail = ProblemFromGRPCCode(st.Code(), st.Message())
+		header  http.Header   = http.Header{}
+		body    []byte
+	)
+
+	// Marshaling a ProblemDetail cannot fail, it only holds strings and an int.
+	body, _ = json.Marshal(problem)
+
+	header.Set("Content-Type", "application/problem+json")
+
+	for _, detail := range st.Details() {
+		var (
+			retryInfo *errdetails.RetryInfo
+			ok        bool
+		)
+
+		retryInfo, ok = detail.(*errdetails.RetryInfo)
+		if ok && retryInfo.GetRetryDelay() != nil {
+			header.Set("Retry-After", strconv.FormatInt(int64(math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds())), 10))
+			break
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", problem.Status, http.StatusText(problem.Status)),
+		StatusCode:    problem.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
@@ -0,0 +1,194 @@
+package gostacode
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestGRPCStatusWithRetryAfter(t *testing.T) {
+	var fixedNow time.Time = time.Date(2015, time.October, 21, 7, 26, 0, 0, time.UTC)
+
+	retryAfterNow = func() time.Time { return fixedNow }
+	defer func() { retryAfterNow = time.Now }()
+
+	var testCases []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		RetryAfterHeader      string
+		ExpectationRetryDelay time.Duration
+		ExpectationError      error
+	} = []struct {
+		Name                  string
+		GRPCCode              codes.Code
+		RetryAfterHeader      string
+		ExpectationRetryDelay time.Duration
+		ExpectationError      error
+	}{
+		{
+			Name:                  "delay seconds",
+			GRPCCode:              codes.Unavailable,
+			RetryAfterHeader:      "120",
+			ExpectationRetryDelay: 120 * time.Second,
+			ExpectationError:      nil,
+		},
+		{
+			Name:                  "http date",
+			GRPCCode:              codes.ResourceExhausted,
+			RetryAfterHeader:      "Wed, 21 Oct 2015 07:28:00 GMT",
+			ExpectationRetryDelay: 2 * time.Minute,
+			ExpectationError:      nil,
+		},
+		{
+			Name:                  "http date in the past",
+			GRPCCode:              codes.ResourceExhausted,
+			RetryAfterHeader:      "Wed, 21 Oct 2015 07:00:00 GMT",
+			ExpectationRetryDelay: 0,
+			ExpectationError:      nil,
+		},
+		{
+			Name:                  "malformed",
+			GRPCCode:              codes.Unavailable,
+			RetryAfterHeader:      "soon",
+			ExpectationRetryDelay: 0,
+			ExpectationError:      ErrInvalidRetryAfter,
+		},
+		{
+			Name:                  "negative",
+			GRPCCode:              codes.Unavailable,
+			RetryAfterHeader:      "-5",
+			ExpectationRetryDelay: 0,
+			ExpectationError:      ErrInvalidRetryAfter,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual *status.Status
+				err    error
+			)
+
+			actual, err = GRPCStatusWithRetryAfter(testCases[i].GRPCCode, testCases[i].RetryAfterHeader)
+
+			if testCases[i].ExpectationError != nil {
+				if !errors.Is(err, testCases[i].ExpectationError) {
+					t.Errorf("expectation is %v, got %v", testCases[i].ExpectationError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expectation is nil error, got %v", err)
+			}
+
+			if testCases[i].GRPCCode != actual.Code() {
+				t.Errorf("expectation is %d, got %d", testCases[i].GRPCCode, actual.Code())
+			}
+
+			if len(actual.Details()) != 1 {
+				t.Fatalf("expectation is 1 detail, got %d", len(actual.Details()))
+			}
+
+			var (
+				retryInfo *errdetails.RetryInfo
+				ok        bool
+			)
+
+			retryInfo, ok = actual.Details()[0].(*errdetails.RetryInfo)
+			if !ok {
+				t.Fatalf("expectation is *errdetails.RetryInfo, got %T", actual.Details()[0])
+			}
+
+			if testCases[i].ExpectationRetryDelay != retryInfo.GetRetryDelay().AsDuration() {
+				t.Errorf("expectation is %s, got %s", testCases[i].ExpectationRetryDelay, retryInfo.GetRetryDelay().AsDuration())
+			}
+		})
+	}
+}
+
+func TestHTTPResponseFromGRPCStatus(t *testing.T) {
+	var (
+		st  *status.Status
+		err error
+	)
+
+	st, err = status.New(codes.ResourceExhausted, "quota exceeded").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(1500 * time.Millisecond),
+	})
+	if err != nil {
+		t.Fatalf("expectation is nil error, got %v", err)
+	}
+
+	var testCases []struct {
+		Name                   string
+		Status                 *status.Status
+		ExpectationStatusCode  int
+		ExpectationBody        string
+		ExpectationRetryAfter  string
+		ExpectationContentType string
+	} = []struct {
+		Name                   string
+		Status                 *status.Status
+		ExpectationStatusCode  int
+		ExpectationBody        string
+		ExpectationRetryAfter  string
+		ExpectationContentType string
+	}{
+		{
+			Name:                   "with retry info",
+			Status:                 st,
+			ExpectationStatusCode:  http.StatusTooManyRequests,
+			ExpectationBody:        `{"type":"about:blank","title":"Too Many Requests","status":429,"detail":"quota exceeded"}`,
+			ExpectationRetryAfter:  "2",
+			ExpectationContentType: "application/problem+json",
+		},
+		{
+			Name:                   "without retry info",
+			Status:                 status.New(codes.NotFound, "user not found"),
+			ExpectationStatusCode:  http.StatusNotFound,
+			ExpectationBody:        `{"type":"about:blank","title":"Not Found","status":404,"detail":"user not found"}`,
+			ExpectationRetryAfter:  "",
+			ExpectationContentType: "application/problem+json",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual     *http.Response = HTTPResponseFromGRPCStatus(testCases[i].Status)
+				actualBody []byte
+				err        error
+			)
+
+			actualBody, err = io.ReadAll(actual.Body)
+			if err != nil {
+				t.Fatalf("expectation is nil error, got %v", err)
+			}
+
+			if testCases[i].ExpectationStatusCode != actual.StatusCode {
+				t.Errorf("expectation status code is %d, got %d", testCases[i].ExpectationStatusCode, actual.StatusCode)
+			}
+
+			if testCases[i].ExpectationBody != string(actualBody) {
+				t.Errorf("expectation body is %s, got %s", testCases[i].ExpectationBody, actualBody)
+			}
+
+			if testCases[i].ExpectationRetryAfter != actual.Header.Get("Retry-After") {
+				t.Errorf("expectation retry-after is %q, got %q", testCases[i].ExpectationRetryAfter, actual.Header.Get("Retry-After"))
+			}
+
+			if testCases[i].ExpectationContentType != actual.Header.Get("Content-Type") {
+				t.Errorf("expectation content-type is %q, got %q", testCases[i].ExpectationContentType, actual.Header.Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// RoundTripHTTP converts httpStatusCode to a gRPC code and back.
+// A result different from httpStatusCode means the conversion is lossy for it.
+func (c *Converter) RoundTripHTTP(httpStatusCode int) int {
+	return c.HTTPStatusCode(c.GRPCCode(httpStatusCode))
+}
+
+// RoundTripGRPC converts grpcCode to an HTTP status code and back.
+// A result different from grpcCode means the conversion is lossy for it.
+func (c *Converter) RoundTripGRPC(grpcCode codes.Code) codes.Code {
+	return c.GRPCCode(c.HTTPStatusCode(grpcCode))
+}
+
+// RoundTripHTTP is (*Converter).RoundTripHTTP on the default converter.
+func RoundTripHTTP(httpStatusCode int) int {
+	return defaultConverter.RoundTripHTTP(httpStatusCode)
+}
+
+// RoundTripGRPC is (*Converter).RoundTripGRPC on the default converter.
+func RoundTripGRPC(grpcCode codes.Code) codes.Code {
+	return defaultConverter.RoundTripGRPC(grpcCode)
+}
@@ -0,0 +1,269 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+// TestRoundTripHTTP documents, for every directly mapped HTTP status code, whether HTTP to gRPC to HTTP is stable.
+func TestRoundTripHTTP(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    int
+		Stable         bool
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    int
+		Stable         bool
+	}{
+		{
+			Name:           http.StatusText(http.StatusOK),
+			HTTPStatusCode: http.StatusOK,
+			Expectation:    http.StatusOK,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusCreated),
+			HTTPStatusCode: http.StatusCreated,
+			Expectation:    http.StatusOK,
+			Stable:         false,
+		},
+		{
+			Name:           http.StatusText(http.StatusBadRequest),
+			HTTPStatusCode: http.StatusBadRequest,
+			Expectation:    http.StatusBadRequest,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusUnauthorized),
+			HTTPStatusCode: http.StatusUnauthorized,
+			Expectation:    http.StatusUnauthorized,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusForbidden),
+			HTTPStatusCode: http.StatusForbidden,
+			Expectation:    http.StatusForbidden,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusNotFound),
+			HTTPStatusCode: http.StatusNotFound,
+			Expectation:    http.StatusNotFound,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusConflict),
+			HTTPStatusCode: http.StatusConflict,
+			Expectation:    http.StatusConflict,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusGone),
+			HTTPStatusCode: http.StatusGone,
+			Expectation:    http.StatusNotFound,
+			Stable:         false,
+		},
+		{
+			Name:           http.StatusText(http.StatusTooManyRequests),
+			HTTPStatusCode: http.StatusTooManyRequests,
+			Expectation:    http.StatusTooManyRequests,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusInternalServerError),
+			HTTPStatusCode: http.StatusInternalServerError,
+			Expectation:    http.StatusInternalServerError,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusNotImplemented),
+			HTTPStatusCode: http.StatusNotImplemented,
+			Expectation:    http.StatusNotImplemented,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusBadGateway),
+			HTTPStatusCode: http.StatusBadGateway,
+			Expectation:    http.StatusServiceUnavailable,
+			Stable:         false,
+		},
+		{
+			Name:           http.StatusText(http.StatusServiceUnavailable),
+			HTTPStatusCode: http.StatusServiceUnavailable,
+			Expectation:    http.StatusServiceUnavailable,
+			Stable:         true,
+		},
+		{
+			Name:           http.StatusText(http.StatusGatewayTimeout),
+			HTTPStatusCode: http.StatusGatewayTimeout,
+			Expectation:    http.StatusGatewayTimeout,
+			Stable:         true,
+		},
+	}
+
+	if len(httpGRPCCodeMap) != len(testCases) {
+		t.Errorf("expectation is one case per mapped http status code, got %d cases for %d codes", len(testCases), len(httpGRPCCodeMap))
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = RoundTripHTTP(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+
+			if testCases[i].Stable != (testCases[i].HTTPStatusCode == actual) {
+				t.Errorf("expectation stable is %t, got %t", testCases[i].Stable, testCases[i].HTTPStatusCode == actual)
+			}
+		})
+	}
+}
+
+// TestRoundTripGRPC documents, for every directly mapped gRPC code, whether gRPC to HTTP to gRPC is stable.
+func TestRoundTripGRPC(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation codes.Code
+		Stable      bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation codes.Code
+		Stable      bool
+	}{
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: codes.OK,
+			Stable:      true,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: codes.Internal,
+			Stable:      false,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: codes.InvalidArgument,
+			Stable:      true,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: codes.DeadlineExceeded,
+			Stable:      true,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: codes.NotFound,
+			Stable:      true,
+		},
+		{
+			Name:        codes.AlreadyExists.String(),
+			GRPCCode:    codes.AlreadyExists,
+			Expectation: codes.AlreadyExists,
+			Stable:      true,
+		},
+		{
+			Name:        codes.PermissionDenied.String(),
+			GRPCCode:    codes.PermissionDenied,
+			Expectation: codes.PermissionDenied,
+			Stable:      true,
+		},
+		{
+			Name:        codes.Unauthenticated.String(),
+			GRPCCode:    codes.Unauthenticated,
+			Expectation: codes.Unauthenticated,
+			Stable:      true,
+		},
+		{
+			Name:        codes.ResourceExhausted.String(),
+			GRPCCode:    codes.ResourceExhausted,
+			Expectation: codes.ResourceExhausted,
+			Stable:      true,
+		},
+		{
+			Name:        codes.FailedPrecondition.String(),
+			GRPCCode:    codes.FailedPrecondition,
+			Expectation: codes.InvalidArgument,
+			Stable:      false,
+		},
+		{
+			Name:        codes.Aborted.String(),
+			GRPCCode:    codes.Aborted,
+			Expectation: codes.AlreadyExists,
+			Stable:      false,
+		},
+		{
+			Name:        codes.OutOfRange.String(),
+			GRPCCode:    codes.OutOfRange,
+			Expectation: codes.InvalidArgument,
+			Stable:      false,
+		},
+		{
+			Name:        codes.Unimplemented.String(),
+			GRPCCode:    codes.Unimplemented,
+			Expectation: codes.Unimplemented,
+			Stable:      true,
+		},
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: codes.Internal,
+			Stable:      true,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: codes.Unavailable,
+			Stable:      true,
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: codes.Internal,
+			Stable:      false,
+		},
+	}
+
+	if len(grpcHTTPCodeMap) != len(testCases) {
+		t.Errorf("expectation is one case per mapped grpc code, got %d cases for %d codes", len(testCases), len(grpcHTTPCodeMap))
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = RoundTripGRPC(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+
+			if testCases[i].Stable != (testCases[i].GRPCCode == actual) {
+				t.Errorf("expectation stable is %t, got %t", testCases[i].Stable, testCases[i].GRPCCode == actual)
+			}
+		})
+	}
+}
+
+func TestConverterRoundTripHTTPWithOverride(t *testing.T) {
+	var c *Converter = NewConverter()
+
+	c.SetHTTPToGRPC(http.StatusConflict, codes.Aborted)
+	c.SetGRPCToHTTP(codes.Aborted, http.StatusPreconditionFailed)
+
+	var actual int = c.RoundTripHTTP(http.StatusConflict)
+
+	if http.StatusPreconditionFailed != actual {
+		t.Errorf("expectation is %d, got %d", http.StatusPreconditionFailed, actual)
+	}
+}
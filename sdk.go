@@ -0,0 +1,86 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	langSDKExceptionClassMap map[string]map[codes.Code]string = map[string]map[codes.Code]string{
+		"java": {
+			codes.Canceled:           "CancellationException",
+			codes.InvalidArgument:    "IllegalArgumentException",
+			codes.DeadlineExceeded:   "TimeoutException",
+			codes.NotFound:           "NoSuchElementException",
+			codes.AlreadyExists:      "IllegalStateException",
+			codes.PermissionDenied:   "SecurityException",
+			codes.FailedPrecondition: "IllegalStateException",
+			codes.OutOfRange:         "IndexOutOfBoundsException",
+			codes.Unimplemented:      "UnsupportedOperationException",
+		},
+		"python": {
+			codes.Canceled:           "CancelledError",
+			codes.InvalidArgument:    "ValueError",
+			codes.DeadlineExceeded:   "TimeoutError",
+			codes.NotFound:           "KeyError",
+			codes.AlreadyExists:      "FileExistsError",
+			codes.PermissionDenied:   "PermissionError",
+			codes.ResourceExhausted:  "MemoryError",
+			codes.FailedPrecondition: "RuntimeError",
+			codes.OutOfRange:         "IndexError",
+			codes.Unimplemented:      "NotImplementedError",
+			codes.Unavailable:        "ConnectionError",
+		},
+	}
+
+	langSDKFallbackExceptionClassMap map[string]string = map[string]string{
+		"java":   "RuntimeException",
+		"python": "Exception",
+	}
+)
+
+// WithSDKExceptionClass overrides the exception class SDKExceptionClass returns for grpcCode in lang.
+// lang may name a language without a catalog.
+func WithSDKExceptionClass(grpcCode codes.Code, lang string, class string) Option {
+	return func(s *converterState) {
+		var (
+			classes map[codes.Code]string
+			ok      bool
+		)
+
+		classes, ok = s.sdkExceptionClasses[lang]
+		if !ok {
+			classes = map[codes.Code]string{}
+			s.sdkExceptionClasses[lang] = classes
+		}
+
+		classes[grpcCode] = class
+	}
+}
+
+// SDKExceptionClass returns the idiomatic exception class name that a generated SDK in lang
+// should raise for grpcCode. Catalogs exist for "java" and "python".
+// Codes missing from a catalog get the language's generic exception, RuntimeException or Exception,
+// and an unknown lang gets an empty string, unless overridden with WithSDKExceptionClass.
+func (c *Converter) SDKExceptionClass(grpcCode codes.Code, lang string) string {
+	var (
+		class string
+		ok    bool
+	)
+
+	class, ok = c.state.Load().sdkExceptionClasses[lang][grpcCode]
+	if ok {
+		return class
+	}
+
+	class, ok = langSDKExceptionClassMap[lang][grpcCode]
+	if ok {
+		return class
+	}
+
+	return langSDKFallbackExceptionClassMap[lang]
+}
+
+// SDKExceptionClassForGRPCCode is (*Converter).SDKExceptionClass on the default converter.
+func SDKExceptionClassForGRPCCode(grpcCode codes.Code, lang string) string {
+	return defaultConverter.SDKExceptionClass(grpcCode, lang)
+}
@@ -0,0 +1,97 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestSDKExceptionClassForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Lang        string
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Lang        string
+		Expectation string
+	}{
+		{
+			Name:        "java invalid argument",
+			GRPCCode:    codes.InvalidArgument,
+			Lang:        "java",
+			Expectation: "IllegalArgumentException",
+		},
+		{
+			Name:        "python invalid argument",
+			GRPCCode:    codes.InvalidArgument,
+			Lang:        "python",
+			Expectation: "ValueError",
+		},
+		{
+			Name:        "java not found",
+			GRPCCode:    codes.NotFound,
+			Lang:        "java",
+			Expectation: "NoSuchElementException",
+		},
+		{
+			Name:        "python not found",
+			GRPCCode:    codes.NotFound,
+			Lang:        "python",
+			Expectation: "KeyError",
+		},
+		{
+			Name:        "java fallback",
+			GRPCCode:    codes.Internal,
+			Lang:        "java",
+			Expectation: "RuntimeException",
+		},
+		{
+			Name:        "python fallback",
+			GRPCCode:    codes.Internal,
+			Lang:        "python",
+			Expectation: "Exception",
+		},
+		{
+			Name:        "unknown language",
+			GRPCCode:    codes.InvalidArgument,
+			Lang:        "cobol",
+			Expectation: "",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = SDKExceptionClassForGRPCCode(testCases[i].GRPCCode, testCases[i].Lang)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithSDKExceptionClass(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithSDKExceptionClass(codes.NotFound, "python", "LookupError"))
+		actual string     = c.SDKExceptionClass(codes.NotFound, "python")
+	)
+
+	if actual != "LookupError" {
+		t.Errorf("expectation is %q, got %q", "LookupError", actual)
+	}
+
+	actual = c.SDKExceptionClass(codes.InvalidArgument, "python")
+
+	if actual != "ValueError" {
+		t.Errorf("expectation is %q, got %q", "ValueError", actual)
+	}
+
+	actual = SDKExceptionClassForGRPCCode(codes.NotFound, "python")
+
+	if actual != "KeyError" {
+		t.Errorf("expectation is %q, got %q", "KeyError", actual)
+	}
+}
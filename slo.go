@@ -0,0 +1,43 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	sloErrorGRPCCodes map[codes.Code]bool = map[codes.Code]bool{
+		codes.Unknown:          true,
+		codes.DeadlineExceeded: true,
+		codes.Internal:         true,
+		codes.Unavailable:      true,
+		codes.DataLoss:         true,
+	}
+)
+
+// WithCountsAgainstSLO overrides whether CountsAgainstSLO reports grpcCode as burning the error budget.
+func WithCountsAgainstSLO(grpcCode codes.Code, counts bool) Option {
+	return func(s *converterState) {
+		s.sloErrorGRPCCodes[grpcCode] = counts
+	}
+}
+
+// CountsAgainstSLO reports whether a call that ended with grpcCode burns the error budget of an SLO.
+// Server-side failures do, while OK and client errors do not, unless overridden with WithCountsAgainstSLO.
+func (c *Converter) CountsAgainstSLO(grpcCode codes.Code) bool {
+	var (
+		counts bool
+		ok     bool
+	)
+
+	counts, ok = c.state.Load().sloErrorGRPCCodes[grpcCode]
+	if ok {
+		return counts
+	}
+
+	return sloErrorGRPCCodes[grpcCode]
+}
+
+// CountsAgainstSLO is (*Converter).CountsAgainstSLO on the default converter.
+func CountsAgainstSLO(grpcCode codes.Code) bool {
+	return defaultConverter.CountsAgainstSLO(grpcCode)
+}
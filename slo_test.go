@@ -0,0 +1,93 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCountsAgainstSLO(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        codes.Internal.String(),
+			GRPCCode:    codes.Internal,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Unavailable.String(),
+			GRPCCode:    codes.Unavailable,
+			Expectation: true,
+		},
+		{
+			Name:        codes.DataLoss.String(),
+			GRPCCode:    codes.DataLoss,
+			Expectation: true,
+		},
+		{
+			Name:        codes.Unknown.String(),
+			GRPCCode:    codes.Unknown,
+			Expectation: true,
+		},
+		{
+			Name:        codes.DeadlineExceeded.String(),
+			GRPCCode:    codes.DeadlineExceeded,
+			Expectation: true,
+		},
+		{
+			Name:        codes.NotFound.String(),
+			GRPCCode:    codes.NotFound,
+			Expectation: false,
+		},
+		{
+			Name:        codes.InvalidArgument.String(),
+			GRPCCode:    codes.InvalidArgument,
+			Expectation: false,
+		},
+		{
+			Name:        codes.OK.String(),
+			GRPCCode:    codes.OK,
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = CountsAgainstSLO(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithCountsAgainstSLO(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithCountsAgainstSLO(codes.ResourceExhausted, true), WithCountsAgainstSLO(codes.Unknown, false))
+		actual bool       = c.CountsAgainstSLO(codes.ResourceExhausted)
+	)
+
+	if !actual {
+		t.Errorf("expectation is %t, got %t", true, actual)
+	}
+
+	actual = c.CountsAgainstSLO(codes.Unknown)
+
+	if actual {
+		t.Errorf("expectation is %t, got %t", false, actual)
+	}
+
+	actual = CountsAgainstSLO(codes.ResourceExhausted)
+
+	if actual {
+		t.Errorf("expectation is %t, got %t", false, actual)
+	}
+}
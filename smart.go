@@ -0,0 +1,44 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodeFromHTTPResponse maps resp.StatusCode like GRPCCodeFromHTTPStatusCode,
+// except that a 503 carrying Retry-After always maps to Unavailable.
+// A nil resp maps to Unknown.
+func GRPCCodeFromHTTPResponse(resp *http.Response) codes.Code {
+	if resp == nil {
+		return codes.Unknown
+	}
+
+	if resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != "" {
+		return codes.Unavailable
+	}
+
+	return GRPCCodeFromHTTPStatusCode(resp.StatusCode)
+}
+
+// GRPCCodeFromHTTPResponseSmart maps resp.StatusCode like GRPCCodeFromHTTPStatusCode, refined by response headers:
+// 403 with WWW-Authenticate maps to Unauthenticated, since the server asks for different credentials,
+// 405 with Allow maps to Unimplemented, since the resource exists but not for this method,
+// and a 5xx with Retry-After maps to Unavailable, since the server expects to recover.
+// A nil resp maps to Unknown.
+func GRPCCodeFromHTTPResponseSmart(resp *http.Response) codes.Code {
+	if resp == nil {
+		return codes.Unknown
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get("WWW-Authenticate") != "":
+		return codes.Unauthenticated
+	case resp.StatusCode == http.StatusMethodNotAllowed && resp.Header.Get("Allow") != "":
+		return codes.Unimplemented
+	case resp.StatusCode >= 500 && resp.StatusCode <= 599 && resp.Header.Get("Retry-After") != "":
+		return codes.Unavailable
+	default:
+		return GRPCCodeFromHTTPStatusCode(resp.StatusCode)
+	}
+}
@@ -0,0 +1,114 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodeFromHTTPResponse(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Response    *http.Response
+		Expectation codes.Code
+	} = []struct {
+		Name        string
+		Response    *http.Response
+		Expectation codes.Code
+	}{
+		{
+			Name:        "503 with Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{"120"}}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "503 without Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "404 with Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{"Retry-After": []string{"120"}}},
+			Expectation: codes.NotFound,
+		},
+		{
+			Name:        "nil",
+			Response:    nil,
+			Expectation: codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPResponse(testCases[i].Response)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestGRPCCodeFromHTTPResponseSmart(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Response    *http.Response
+		Expectation codes.Code
+	} = []struct {
+		Name        string
+		Response    *http.Response
+		Expectation codes.Code
+	}{
+		{
+			Name:        "403 with WWW-Authenticate",
+			Response:    &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{"Www-Authenticate": []string{`Bearer error="invalid_token"`}}},
+			Expectation: codes.Unauthenticated,
+		},
+		{
+			Name:        "403 without WWW-Authenticate",
+			Response:    &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}},
+			Expectation: codes.PermissionDenied,
+		},
+		{
+			Name:        "405 with Allow",
+			Response:    &http.Response{StatusCode: http.StatusMethodNotAllowed, Header: http.Header{"Allow": []string{"GET, HEAD"}}},
+			Expectation: codes.Unimplemented,
+		},
+		{
+			Name:        "503 with Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{"120"}}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "500 with Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{"Retry-After": []string{"120"}}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "429 with Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"120"}}},
+			Expectation: codes.ResourceExhausted,
+		},
+		{
+			Name:        "500 without Retry-After",
+			Response:    &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}},
+			Expectation: codes.Internal,
+		},
+		{
+			Name:        "nil",
+			Response:    nil,
+			Expectation: codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromHTTPResponseSmart(testCases[i].Response)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
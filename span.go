@@ -0,0 +1,17 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// SpanStatusFromGRPCCode returns whether a server span that ended with grpcCode should be marked as an error
+// and the description to set with it, ready to be fed into span.SetStatus.
+// Following the OpenTelemetry conventions for server spans, only codes that IsServerError reports are errors,
+// and the description, the code name, is only set for errors.
+func SpanStatusFromGRPCCode(grpcCode codes.Code) (bool, string) {
+	if !IsServerError(grpcCode) {
+		return false, ""
+	}
+
+	return true, grpcCode.String()
+}
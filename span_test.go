@@ -0,0 +1,59 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestSpanStatusFromGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name                   string
+		GRPCCode               codes.Code
+		ExpectationIsError     bool
+		ExpectationDescription string
+	} = []struct {
+		Name                   string
+		GRPCCode               codes.Code
+		ExpectationIsError     bool
+		ExpectationDescription string
+	}{
+		{
+			Name:                   codes.OK.String(),
+			GRPCCode:               codes.OK,
+			ExpectationIsError:     false,
+			ExpectationDescription: "",
+		},
+		{
+			Name:                   codes.NotFound.String(),
+			GRPCCode:               codes.NotFound,
+			ExpectationIsError:     false,
+			ExpectationDescription: "",
+		},
+		{
+			Name:                   codes.Internal.String(),
+			GRPCCode:               codes.Internal,
+			ExpectationIsError:     true,
+			ExpectationDescription: "Internal",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualIsError     bool
+				actualDescription string
+			)
+
+			actualIsError, actualDescription = SpanStatusFromGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].ExpectationIsError != actualIsError {
+				t.Errorf("expectation is %t, got %t", testCases[i].ExpectationIsError, actualIsError)
+			}
+
+			if testCases[i].ExpectationDescription != actualDescription {
+				t.Errorf("expectation is %q, got %q", testCases[i].ExpectationDescription, actualDescription)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package gostacode
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	methodSuccessStatusMap map[string]int = map[string]int{
+		http.MethodGet:    http.StatusOK,
+		http.MethodPut:    http.StatusOK,
+		http.MethodPatch:  http.StatusOK,
+		http.MethodPost:   http.StatusCreated,
+		http.MethodDelete: http.StatusNoContent,
+	}
+)
+
+// SuccessStatusForMethod returns the canonical HTTP status code of a successful response to method.
+// Methods without a configured status get 200 OK.
+func (c *Converter) SuccessStatusForMethod(method string) int {
+	var (
+		httpStatusCode int
+		ok             bool
+	)
+
+	httpStatusCode, ok = c.state.Load().successStatus[method]
+	if !ok {
+		return http.StatusOK
+	}
+
+	return httpStatusCode
+}
+
+// SetSuccessStatusForMethod overrides the status returned by SuccessStatusForMethod for method.
+func (c *Converter) SetSuccessStatusForMethod(method string, httpStatusCode int) {
+	c.update(func(s *converterState) {
+		s.successStatus[method] = httpStatusCode
+	})
+}
+
+// SuccessStatusForMethod is (*Converter).SuccessStatusForMethod on the default converter.
+func SuccessStatusForMethod(method string) int {
+	return defaultConverter.SuccessStatusForMethod(method)
+}
+
+// SetSuccessStatusForMethod is (*Converter).SetSuccessStatusForMethod on the default converter.
+func SetSuccessStatusForMethod(method string, httpStatusCode int) {
+	defaultConverter.SetSuccessStatusForMethod(method, httpStatusCode)
+}
+
+// ValidateSuccessStatusConfig checks that every success status configured on c is a 2xx status
+// that c converts back to codes.OK. Methods are checked in alphabetical order
+// and the first misconfigured one is reported, wrapping ErrInvalidSuccessStatus.
+func ValidateSuccessStatusConfig(c *Converter) error {
+	var (
+		s       *converterState = c.state.Load()
+		methods []string
+	)
+
+	for method := range s.successStatus {
+		methods = append(methods, method)
+	}
+
+	sort.Strings(methods)
+
+	for i := range methods {
+		var (
+			httpStatusCode int = s.successStatus[methods[i]]
+			grpcCode       codes.Code
+		)
+
+		grpcCode, _ = s.grpcCode(httpStatusCode)
+
+		if httpStatusCode < 200 || httpStatusCode > 299 {
+			return fmt.Errorf("%w: %s maps to %d, which is not a 2xx status", ErrInvalidSuccessStatus, methods[i], httpStatusCode)
+		}
+
+		if grpcCode != codes.OK {
+			return fmt.Errorf("%w: %s maps to %d, which converts back to %s", ErrInvalidSuccessStatus, methods[i], httpStatusCode, grpcCode)
+		}
+	}
+
+	return nil
+}
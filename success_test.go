@@ -0,0 +1,113 @@
+package gostacode
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestSuccessStatusForMethod(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Method      string
+		Expectation int
+	} = []struct {
+		Name        string
+		Method      string
+		Expectation int
+	}{
+		{
+			Name:        http.MethodPost,
+			Method:      http.MethodPost,
+			Expectation: http.StatusCreated,
+		},
+		{
+			Name:        http.MethodDelete,
+			Method:      http.MethodDelete,
+			Expectation: http.StatusNoContent,
+		},
+		{
+			Name:        http.MethodGet,
+			Method:      http.MethodGet,
+			Expectation: http.StatusOK,
+		},
+		{
+			Name:        http.MethodOptions,
+			Method:      http.MethodOptions,
+			Expectation: http.StatusOK,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = SuccessStatusForMethod(testCases[i].Method)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestSetSuccessStatusForMethod(t *testing.T) {
+	var previous int = SuccessStatusForMethod(http.MethodPost)
+	defer SetSuccessStatusForMethod(http.MethodPost, previous)
+
+	SetSuccessStatusForMethod(http.MethodPost, http.StatusAccepted)
+
+	var actual int = SuccessStatusForMethod(http.MethodPost)
+
+	if actual != http.StatusAccepted {
+		t.Errorf("expectation is %d, got %d", http.StatusAccepted, actual)
+	}
+}
+
+func TestValidateSuccessStatusConfig(t *testing.T) {
+	var (
+		notSuccess    *Converter = NewConverter()
+		notOKReversed *Converter = NewConverter()
+	)
+
+	notSuccess.SetSuccessStatusForMethod(http.MethodPost, http.StatusNotFound)
+
+	notOKReversed.SetSuccessStatusForMethod(http.MethodPost, http.StatusAccepted)
+	notOKReversed.SetHTTPToGRPC(http.StatusAccepted, codes.Aborted)
+
+	var testCases []struct {
+		Name        string
+		Converter   *Converter
+		Expectation error
+	} = []struct {
+		Name        string
+		Converter   *Converter
+		Expectation error
+	}{
+		{
+			Name:        "default",
+			Converter:   NewConverter(),
+			Expectation: nil,
+		},
+		{
+			Name:        "not a 2xx status",
+			Converter:   notSuccess,
+			Expectation: ErrInvalidSuccessStatus,
+		},
+		{
+			Name:        "not converted back to OK",
+			Converter:   notOKReversed,
+			Expectation: ErrInvalidSuccessStatus,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = ValidateSuccessStatusConfig(testCases[i].Converter)
+
+			if !errors.Is(actual, testCases[i].Expectation) {
+				t.Errorf("expectation is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Tone selects the catalog ErrorTitle picks a title from.
+type Tone int
+
+const (
+	// ToneFriendly titles speak to end users, such as "We couldn't find that".
+	ToneFriendly Tone = iota
+	// ToneTechnical titles name the error, such as "Resource not found".
+	ToneTechnical
+)
+
+var (
+	toneErrorTitleMap map[Tone]map[codes.Code]string = map[Tone]map[codes.Code]string{
+		ToneFriendly: {
+			codes.OK:                 "All done",
+			codes.Canceled:           "The request was cancelled",
+			codes.Unknown:            "Something went wrong",
+			codes.InvalidArgument:    "Some of the details don't look right",
+			codes.DeadlineExceeded:   "This is taking longer than expected",
+			codes.NotFound:           "We couldn't find that",
+			codes.AlreadyExists:      "That already exists",
+			codes.PermissionDenied:   "You don't have access to this",
+			codes.ResourceExhausted:  "You're going a bit fast",
+			codes.FailedPrecondition: "This can't be done right now",
+			codes.Aborted:            "Something changed while we were working on it",
+			codes.OutOfRange:         "That's outside the allowed range",
+			codes.Unimplemented:      "This isn't available yet",
+			codes.Internal:           "Something went wrong on our side",
+			codes.Unavailable:        "We're temporarily unavailable",
+			codes.DataLoss:           "Something went wrong on our side",
+			codes.Unauthenticated:    "Please sign in to continue",
+		},
+		ToneTechnical: {
+			codes.OK:                 "Success",
+			codes.Canceled:           "Request canceled",
+			codes.Unknown:            "Unknown error",
+			codes.InvalidArgument:    "Invalid argument",
+			codes.DeadlineExceeded:   "Deadline exceeded",
+			codes.NotFound:           "Resource not found",
+			codes.AlreadyExists:      "Resource already exists",
+			codes.PermissionDenied:   "Permission denied",
+			codes.ResourceExhausted:  "Resource exhausted",
+			codes.FailedPrecondition: "Failed precondition",
+			codes.Aborted:            "Operation aborted",
+			codes.OutOfRange:         "Out of range",
+			codes.Unimplemented:      "Not implemented",
+			codes.Internal:           "Internal error",
+			codes.Unavailable:        "Service unavailable",
+			codes.DataLoss:           "Data loss",
+			codes.Unauthenticated:    "Unauthenticated",
+		},
+	}
+)
+
+// WithErrorTitle overrides the title ErrorTitle returns for grpcCode in tone.
+func WithErrorTitle(tone Tone, grpcCode codes.Code, title string) Option {
+	return func(s *converterState) {
+		var (
+			titles map[codes.Code]string
+			ok     bool
+		)
+
+		titles, ok = s.errorTitles[tone]
+		if !ok {
+			titles = map[codes.Code]string{}
+			s.errorTitles[tone] = titles
+		}
+
+		titles[grpcCode] = title
+	}
+}
+
+// ErrorTitle returns the user-facing error title for grpcCode in tone, unless overridden with WithErrorTitle.
+// Codes missing from the catalog get "Something went wrong" in ToneFriendly
+// and the status text of the mapped HTTP status code otherwise.
+func (c *Converter) ErrorTitle(grpcCode codes.Code, tone Tone) string {
+	var (
+		s     *converterState = c.state.Load()
+		title string
+		ok    bool
+	)
+
+	title, ok = s.errorTitles[tone][grpcCode]
+	if ok {
+		return title
+	}
+
+	title, ok = toneErrorTitleMap[tone][grpcCode]
+	if ok {
+		return title
+	}
+
+	if tone == ToneFriendly {
+		return "Something went wrong"
+	}
+
+	return http.StatusText(LookupOrDefault(s.grpcToHTTP, grpcCode, http.StatusInternalServerError))
+}
+
+// ErrorTitleForGRPCCode is (*Converter).ErrorTitle on the default converter.
+func ErrorTitleForGRPCCode(grpcCode codes.Code, tone Tone) string {
+	return defaultConverter.ErrorTitle(grpcCode, tone)
+}
@@ -0,0 +1,79 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorTitleForGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Tone        Tone
+		Expectation string
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Tone        Tone
+		Expectation string
+	}{
+		{
+			Name:        "not found friendly",
+			GRPCCode:    codes.NotFound,
+			Tone:        ToneFriendly,
+			Expectation: "We couldn't find that",
+		},
+		{
+			Name:        "not found technical",
+			GRPCCode:    codes.NotFound,
+			Tone:        ToneTechnical,
+			Expectation: "Resource not found",
+		},
+		{
+			Name:        "unknown code friendly",
+			GRPCCode:    codes.Code(999),
+			Tone:        ToneFriendly,
+			Expectation: "Something went wrong",
+		},
+		{
+			Name:        "unknown code technical",
+			GRPCCode:    codes.Code(999),
+			Tone:        ToneTechnical,
+			Expectation: "Internal Server Error",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = ErrorTitleForGRPCCode(testCases[i].GRPCCode, testCases[i].Tone)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %q, got %q", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestWithErrorTitle(t *testing.T) {
+	var (
+		c      *Converter = NewConverter(WithErrorTitle(ToneFriendly, codes.NotFound, "Nothing here"))
+		actual string     = c.ErrorTitle(codes.NotFound, ToneFriendly)
+	)
+
+	if actual != "Nothing here" {
+		t.Errorf("expectation is %q, got %q", "Nothing here", actual)
+	}
+
+	actual = c.ErrorTitle(codes.NotFound, ToneTechnical)
+
+	if actual != "Resource not found" {
+		t.Errorf("expectation is %q, got %q", "Resource not found", actual)
+	}
+
+	actual = ErrorTitleForGRPCCode(codes.NotFound, ToneFriendly)
+
+	if actual != "We couldn't find that" {
+		t.Errorf("expectation is %q, got %q", "We couldn't find that", actual)
+	}
+}
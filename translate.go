@@ -0,0 +1,46 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// TranslateInput is the input of Translate.
+// A non-zero HTTPStatusCode is translated to gRPC, otherwise GRPCCode is translated to HTTP using Context.
+type TranslateInput struct {
+	HTTPStatusCode int
+	GRPCCode       codes.Code
+	Context        MappingContext
+}
+
+// TranslateResult holds every representation of a translated status.
+type TranslateResult struct {
+	HTTPStatus int
+	GRPCCode   codes.Code
+	HTTPText   string
+	GRPCName   string
+	Retryable  bool
+	// Class is the HTTP status class, such as 4 for a 4xx status.
+	Class int
+}
+
+// Translate converts input to every representation at once.
+func Translate(input TranslateInput) TranslateResult {
+	var result TranslateResult
+
+	if input.HTTPStatusCode != 0 {
+		result.HTTPStatus = input.HTTPStatusCode
+		result.GRPCCode = GRPCCodeFromHTTPStatusCode(input.HTTPStatusCode)
+	} else {
+		result.GRPCCode = input.GRPCCode
+		result.HTTPStatus = HTTPStatusForGRPCCodeContext(input.GRPCCode, input.Context)
+	}
+
+	result.HTTPText = http.StatusText(result.HTTPStatus)
+	result.GRPCName = result.GRPCCode.String()
+	result.Retryable = retryableGRPCCodes[result.GRPCCode]
+	result.Class = result.HTTPStatus / 100
+
+	return result
+}
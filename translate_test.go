@@ -0,0 +1,67 @@
+package gostacode
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestTranslate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Input       TranslateInput
+		Expectation TranslateResult
+	} = []struct {
+		Name        string
+		Input       TranslateInput
+		Expectation TranslateResult
+	}{
+		{
+			Name:  "http status input",
+			Input: TranslateInput{HTTPStatusCode: http.StatusServiceUnavailable},
+			Expectation: TranslateResult{
+				HTTPStatus: http.StatusServiceUnavailable,
+				GRPCCode:   codes.Unavailable,
+				HTTPText:   "Service Unavailable",
+				GRPCName:   "Unavailable",
+				Retryable:  true,
+				Class:      5,
+			},
+		},
+		{
+			Name:  "grpc code input",
+			Input: TranslateInput{GRPCCode: codes.NotFound},
+			Expectation: TranslateResult{
+				HTTPStatus: http.StatusNotFound,
+				GRPCCode:   codes.NotFound,
+				HTTPText:   "Not Found",
+				GRPCName:   "NotFound",
+				Retryable:  false,
+				Class:      4,
+			},
+		},
+		{
+			Name:  "grpc code input with context",
+			Input: TranslateInput{GRPCCode: codes.OK, Context: MappingContext{Method: http.MethodPost}},
+			Expectation: TranslateResult{
+				HTTPStatus: http.StatusCreated,
+				GRPCCode:   codes.OK,
+				HTTPText:   "Created",
+				GRPCName:   "OK",
+				Retryable:  false,
+				Class:      2,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual TranslateResult = Translate(testCases[i].Input)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package gostacode
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodeFromTransportError returns the gRPC code for an HTTP request that failed before a response was received.
+// Context deadlines and network timeouts map to DeadlineExceeded, context cancellation to Canceled,
+// refused or reset connections, failed dials and DNS failures to Unavailable, and anything else to Unknown.
+// A nil err maps to OK.
+func GRPCCodeFromTransportError(err error) codes.Code {
+	var (
+		netErr net.Error
+		opErr  *net.OpError
+		dnsErr *net.DNSError
+	)
+
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return codes.DeadlineExceeded
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET):
+		return codes.Unavailable
+	case errors.As(err, &dnsErr):
+		return codes.Unavailable
+	case errors.As(err, &opErr) && opErr.Op == "dial":
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
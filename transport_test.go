@@ -0,0 +1,83 @@
+package gostacode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestGRPCCodeFromTransportError(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Error       error
+		Expectation codes.Code
+	} = []struct {
+		Name        string
+		Error       error
+		Expectation codes.Code
+	}{
+		{
+			Name:        "nil",
+			Error:       nil,
+			Expectation: codes.OK,
+		},
+		{
+			Name:        "context deadline exceeded",
+			Error:       fmt.Errorf("get: %w", context.DeadlineExceeded),
+			Expectation: codes.DeadlineExceeded,
+		},
+		{
+			Name:        "context canceled",
+			Error:       &url.Error{Op: "Get", URL: "http://example.com", Err: context.Canceled},
+			Expectation: codes.Canceled,
+		},
+		{
+			Name:        "network timeout",
+			Error:       &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}},
+			Expectation: codes.DeadlineExceeded,
+		},
+		{
+			Name:        "connection refused",
+			Error:       &url.Error{Op: "Get", URL: "http://example.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "connection reset",
+			Error:       &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "dns failure",
+			Error:       &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}},
+			Expectation: codes.Unavailable,
+		},
+		{
+			Name:        "other",
+			Error:       errors.New("malformed response"),
+			Expectation: codes.Unknown,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual codes.Code = GRPCCodeFromTransportError(testCases[i].Error)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
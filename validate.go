@@ -0,0 +1,15 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// IsValidGRPCCode reports whether grpcCode is one of the 17 canonical gRPC codes, OK through Unauthenticated.
+func IsValidGRPCCode(grpcCode codes.Code) bool {
+	return grpcCode <= codes.Unauthenticated
+}
+
+// IsValidHTTPStatusCode reports whether httpStatusCode is in the 100 to 599 range.
+func IsValidHTTPStatusCode(httpStatusCode int) bool {
+	return httpStatusCode >= minHTTPStatusCode && httpStatusCode <= maxHTTPStatusCode
+}
@@ -0,0 +1,103 @@
+package gostacode
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsValidGRPCCode(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	} = []struct {
+		Name        string
+		GRPCCode    codes.Code
+		Expectation bool
+	}{
+		{
+			Name:        "0",
+			GRPCCode:    codes.Code(0),
+			Expectation: true,
+		},
+		{
+			Name:        "16",
+			GRPCCode:    codes.Code(16),
+			Expectation: true,
+		},
+		{
+			Name:        "17",
+			GRPCCode:    codes.Code(17),
+			Expectation: false,
+		},
+		{
+			Name:        "-1",
+			GRPCCode:    codes.Code(^uint32(0)),
+			Expectation: false,
+		},
+		{
+			Name:        "999",
+			GRPCCode:    codes.Code(999),
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsValidGRPCCode(testCases[i].GRPCCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIsValidHTTPStatusCode(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	} = []struct {
+		Name           string
+		HTTPStatusCode int
+		Expectation    bool
+	}{
+		{
+			Name:           "-1",
+			HTTPStatusCode: -1,
+			Expectation:    false,
+		},
+		{
+			Name:           "99",
+			HTTPStatusCode: 99,
+			Expectation:    false,
+		},
+		{
+			Name:           "100",
+			HTTPStatusCode: 100,
+			Expectation:    true,
+		},
+		{
+			Name:           "599",
+			HTTPStatusCode: 599,
+			Expectation:    true,
+		},
+		{
+			Name:           "600",
+			HTTPStatusCode: 600,
+			Expectation:    false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsValidHTTPStatusCode(testCases[i].HTTPStatusCode)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
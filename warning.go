@@ -0,0 +1,31 @@
+package gostacode
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	grpcWarningTextMap map[codes.Code]string = map[codes.Code]string{
+		codes.Unavailable:      "Unavailable upstream",
+		codes.DeadlineExceeded: "Upstream deadline exceeded",
+	}
+)
+
+// WarningHeaderForGRPCCode returns an RFC 7234 Warning header value with the 199 miscellaneous warn-code
+// for a response derived from an upstream that answered with grpcCode, such as `199 - "Unavailable upstream"`.
+// It returns an empty string for codes that do not warrant a warning.
+func WarningHeaderForGRPCCode(grpcCode codes.Code) string {
+	var (
+		text string
+		ok   bool
+	)
+
+	text, ok = grpcWarningTextMap[grpcCode]
+	if !ok {
+		return ""
+	}
+
+	return "199 - " + strconv.Quote(text)
+}
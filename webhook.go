@@ -0,0 +1,53 @@
+package gostacode
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WebhookDisposition is what to do with a webhook delivery after its receiver answered.
+type WebhookDisposition int
+
+const (
+	// WebhookDispositionDelivered marks the delivery as done.
+	WebhookDispositionDelivered WebhookDisposition = iota
+	// WebhookDispositionUnsubscribe removes the subscription, since the receiver is gone.
+	WebhookDispositionUnsubscribe
+	// WebhookDispositionBackoff retries the delivery after slowing down.
+	WebhookDispositionBackoff
+	// WebhookDispositionRetry retries the delivery.
+	WebhookDispositionRetry
+	// WebhookDispositionDrop gives up on the delivery, since retrying cannot succeed.
+	WebhookDispositionDrop
+)
+
+var (
+	grpcWebhookDispositionMap map[codes.Code]WebhookDisposition = map[codes.Code]WebhookDisposition{
+		codes.OK:                WebhookDispositionDelivered,
+		codes.NotFound:          WebhookDispositionUnsubscribe,
+		codes.ResourceExhausted: WebhookDispositionBackoff,
+		codes.Unavailable:       WebhookDispositionRetry,
+		codes.InvalidArgument:   WebhookDispositionDrop,
+	}
+)
+
+// WebhookDispositionFromGRPCCode returns what to do with a webhook delivery whose receiver answered with grpcCode.
+// Codes without an explicit disposition are retried when retryable or mapped to a 5xx status, and dropped otherwise.
+func WebhookDispositionFromGRPCCode(grpcCode codes.Code) WebhookDisposition {
+	var (
+		disposition WebhookDisposition
+		ok          bool
+	)
+
+	disposition, ok = grpcWebhookDispositionMap[grpcCode]
+	if ok {
+		return disposition
+	}
+
+	if retryableGRPCCodes[grpcCode] || HTTPStatusCodeFromGRPCCode(grpcCode) >= http.StatusInternalServerError {
+		return WebhookDispositionRetry
+	}
+
+	return WebhookDispositionDrop
+}
@@ -0,0 +1,46 @@
+package gostacode
+
+import (
+	"google.golang.org/grpc/codes"
+)
+
+// WebSocket close codes as defined by RFC 6455 section 7.4.1,
+// plus 1012 and 1013 from the IANA WebSocket Close Code Number Registry.
+const (
+	webSocketCloseNormalClosure   int = 1000
+	webSocketCloseInvalidPayload  int = 1007
+	webSocketClosePolicyViolation int = 1008
+	webSocketCloseInternalError   int = 1011
+	webSocketCloseServiceRestart  int = 1012
+	webSocketCloseTryAgainLater   int = 1013
+)
+
+var (
+	grpcWebSocketCloseCodeMap map[codes.Code]int = map[codes.Code]int{
+		codes.OK:                webSocketCloseNormalClosure,
+		codes.InvalidArgument:   webSocketCloseInvalidPayload,
+		codes.PermissionDenied:  webSocketClosePolicyViolation,
+		codes.Unauthenticated:   webSocketClosePolicyViolation,
+		codes.ResourceExhausted: webSocketCloseTryAgainLater,
+		codes.Internal:          webSocketCloseInternalError,
+		codes.Unavailable:       webSocketCloseServiceRestart,
+	}
+)
+
+// WebSocketCloseCodeFromGRPCCode returns the WebSocket close code for a stream that ended with grpcCode:
+// 1000 Normal Closure for OK, 1007 Invalid Frame Payload Data for InvalidArgument,
+// 1008 Policy Violation for PermissionDenied and Unauthenticated, 1013 Try Again Later for ResourceExhausted,
+// 1012 Service Restart for Unavailable and 1011 Internal Error for Internal and any other code.
+func WebSocketCloseCodeFromGRPCCode(grpcCode codes.Code) int {
+	var (
+		closeCode int
+		ok        bool
+	)
+
+	closeCode, ok = grpcWebSocketCloseCodeMap[grpcCode]
+	if !ok {
+		return webSocketCloseInternalError
+	}
+
+	return closeCode
+}